@@ -8,9 +8,13 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/skip-mev/go-fast-solver/exposure"
 	"github.com/skip-mev/go-fast-solver/gasmonitor"
+	"github.com/skip-mev/go-fast-solver/inventory"
 
 	"github.com/skip-mev/go-fast-solver/shared/oracle"
+	"github.com/skip-mev/go-fast-solver/shared/orderbus"
+	"github.com/skip-mev/go-fast-solver/shared/screening"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 
@@ -81,7 +85,7 @@ func main() {
 	cosmosTxExecutor := cosmos.DefaultSerializedCosmosTxExecutor()
 	evmTxExecutor := evm.DefaultEVMTxExecutor()
 
-	clientManager := clientmanager.NewClientManager(keyStore, cosmosTxExecutor)
+	clientManager := clientmanager.NewClientManager(keyStore, cosmosTxExecutor, evmTxExecutor)
 
 	dbConn, err := connect.ConnectAndMigrate(ctx, *sqliteDBPath, *migrationsPath)
 	if err != nil {
@@ -100,7 +104,7 @@ func main() {
 	cachedCoinGeckoClient := coingecko.NewCachedPriceClient(coingeckoClient, 15*time.Minute)
 	txPriceOracle := oracle.NewOracle(cachedCoinGeckoClient)
 
-	hype, err := hyperlane.NewMultiClientFromConfig(ctx, evmManager, keyStore, txPriceOracle, evmTxExecutor)
+	hype, err := hyperlane.NewMultiClientFromConfig(ctx, evmManager, keyStore, txPriceOracle, evmTxExecutor, cosmosTxExecutor)
 	if err != nil {
 		lmt.Logger(ctx).Fatal("creating hyperlane multi client from config", zap.Error(err))
 	}
@@ -108,6 +112,15 @@ func main() {
 	relayer := hyperlane.NewRelayer(hype, make(map[string]string))
 	relayerRunner := hyperlane.NewRelayerRunner(db.New(dbConn), hype, relayer)
 
+	screener, err := screening.NewScreener(cfg.OrderFillerConfig.ScreeningListPath)
+	if err != nil {
+		lmt.Logger(ctx).Fatal("creating order screener", zap.Error(err))
+	}
+
+	inventoryLedger := inventory.NewLedger()
+	newOrders := orderbus.NewOrderBus()
+	exposureTracker := exposure.NewTracker(db.New(dbConn), inventoryLedger)
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	eg.Go(func() error {
@@ -119,11 +132,18 @@ func main() {
 	})
 
 	eg.Go(func() error {
-		orderFillHandler := order_fulfillment_handler.NewOrderFulfillmentHandler(db.New(dbConn), clientManager, relayerRunner)
+		orderFillHandler := order_fulfillment_handler.NewOrderFulfillmentHandler(db.New(dbConn), clientManager, relayerRunner, txPriceOracle, inventoryLedger, screener, exposureTracker)
+		if *fillOrders {
+			if err := orderFillHandler.ApproveGateways(ctx); err != nil {
+				return fmt.Errorf("approving gateways: %w", err)
+			}
+		}
 		r, err := orderfulfiller.NewOrderFulfiller(
 			ctx,
 			db.New(dbConn),
+			newOrders,
 			cfg.OrderFillerConfig.OrderFillWorkerCount,
+			cfg.OrderFillerConfig.PendingOrderPollInterval,
 			orderFillHandler,
 			*fillOrders,
 			*refundOrders,
@@ -154,7 +174,7 @@ func main() {
 	})
 
 	eg.Go(func() error {
-		r, err := txverifier.NewTxVerifier(ctx, db.New(dbConn), clientManager, txPriceOracle)
+		r, err := txverifier.NewTxVerifier(ctx, db.New(dbConn), clientManager, txPriceOracle, inventoryLedger)
 		if err != nil {
 			return err
 		}
@@ -163,7 +183,7 @@ func main() {
 	})
 
 	eg.Go(func() error {
-		transferMonitor := transfermonitor.NewTransferMonitor(db.New(dbConn), *quickStart, cfg.TransferMonitorConfig.PollInterval)
+		transferMonitor := transfermonitor.NewTransferMonitor(db.New(dbConn), newOrders, *quickStart, cfg.TransferMonitorConfig.PollInterval)
 		err := transferMonitor.Start(ctx)
 		if err != nil {
 			return fmt.Errorf("creating transfer monitor: %w", err)
@@ -180,6 +200,30 @@ func main() {
 		return nil
 	})
 
+	eg.Go(func() error {
+		if err := screener.Start(ctx); err != nil {
+			return fmt.Errorf("screening list reloader: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		err := exposureTracker.Start(ctx)
+		if err != nil {
+			return fmt.Errorf("creating exposure tracker: %w", err)
+		}
+		return nil
+	})
+
+	eg.Go(func() error {
+		inventoryReconciler := inventory.NewReconciler(db.New(dbConn), clientManager, inventoryLedger)
+		err := inventoryReconciler.Start(ctx)
+		if err != nil {
+			return fmt.Errorf("creating inventory reconciler: %w", err)
+		}
+		return nil
+	})
+
 	eg.Go(func() error {
 		if err := relayerRunner.Run(ctx); err != nil {
 			return fmt.Errorf("relayer runner: %w", err)
@@ -25,7 +25,7 @@ var inventoryCmd = &cobra.Command{
 
 		_, cctpClientManager := setupClients(ctx, cmd)
 
-		pendingSettlements, err := ordersettler.DetectPendingSettlements(ctx, cctpClientManager, nil)
+		pendingSettlements, err := ordersettler.DetectPendingSettlements(ctx, cctpClientManager, database)
 		if err != nil {
 			lmt.Logger(ctx).Fatal("Failed to get pending settlements", zap.Error(err))
 		}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/skip-mev/go-fast-solver/shared/oracle"
+	"github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 
 	"os/signal"
@@ -100,7 +101,8 @@ var relayCmd = &cobra.Command{
 		cachedCoinGeckoClient := coingecko.NewCachedPriceClient(coingeckoClient, 15*time.Minute)
 		txPriceOracle := oracle.NewOracle(cachedCoinGeckoClient)
 		evmTxExecutor := evm.DefaultEVMTxExecutor()
-		hype, err := hyperlane.NewMultiClientFromConfig(ctx, evmrpc.NewEVMRPCClientManager(), keyStore, txPriceOracle, evmTxExecutor)
+		cosmosTxExecutor := cosmos.DefaultSerializedCosmosTxExecutor()
+		hype, err := hyperlane.NewMultiClientFromConfig(ctx, evmrpc.NewEVMRPCClientManager(), keyStore, txPriceOracle, evmTxExecutor, cosmosTxExecutor)
 		if err != nil {
 			lmt.Logger(ctx).Error("Error creating hyperlane multi client from config", zap.Error(err))
 		}
@@ -122,5 +124,5 @@ func init() {
 
 	relayCmd.Flags().String("origin-chain-id", "", "chain the message is emitted from")
 	relayCmd.Flags().String("origin-tx-hash", "", "transaction the message emitted from")
-	relayCmd.Flags().String("checkpoint-storage-location-override", "{}", "map of validator addresses to storage locations")
+	relayCmd.Flags().String("checkpoint-storage-location-override", "{}", "map of validator addresses to storage locations (file://, s3://, gs:// or https://)")
 }
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var settlementBatchesCmd = &cobra.Command{
+	Use:   "settlement-batches",
+	Short: "Show the lifecycle of recent settlement batches",
+	Long: `Show the most recent settlement batches initiated by the solver, including
+why each batch was settled, its value and profit, and when it was initiated,
+relayed via hyperlane and completed.`,
+	Example: `solver settlement-batches --limit 10`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := setupContext(cmd)
+
+		limit, err := cmd.Flags().GetInt64("limit")
+		if err != nil {
+			lmt.Logger(ctx).Fatal("Failed to get limit flag", zap.Error(err))
+		}
+
+		database, err := setupDatabase(ctx, cmd)
+		if err != nil {
+			lmt.Logger(ctx).Fatal("Failed to setup database", zap.Error(err))
+		}
+
+		batches, err := database.GetRecentSettlementBatches(ctx, limit)
+		if err != nil {
+			lmt.Logger(ctx).Fatal("Failed to get settlement batches", zap.Error(err))
+		}
+
+		fmt.Println("\nSettlement Batches:")
+		fmt.Println("------------------")
+
+		for _, batch := range batches {
+			fmt.Printf("\nBatch %d from %s to %s:\n", batch.ID, batch.SourceChainID, batch.DestinationChainID)
+			fmt.Printf("  Status: %s\n", batch.SettlementStatus)
+			if batch.SettlementStatusMessage.Valid {
+				fmt.Printf("  Status Message: %s\n", batch.SettlementStatusMessage.String)
+			}
+			fmt.Printf("  Trigger: %s\n", batch.SettlementTrigger)
+			fmt.Printf("  Orders: %d\n", batch.NumOrders)
+			fmt.Printf("  Value: %s USDC\n", normalizeUUSDCString(batch.TotalValue))
+			fmt.Printf("  Profit: %s USDC\n", normalizeUUSDCString(batch.TotalProfit))
+			fmt.Printf("  Initiate Tx: %s\n", batch.InitiateSettlementTx)
+			fmt.Printf("  Submitted: %s\n", batch.CreatedAt.Format(time.RFC3339))
+			fmt.Printf("  Initiated: %s\n", formatNullTime(batch.InitiatedAt))
+			fmt.Printf("  Relay Submitted: %s\n", formatNullTime(batch.RelaySubmittedAt))
+			if batch.MaxRelayTxFeeUusdc.Valid {
+				fmt.Printf("  Max Relay Fee: %s USDC\n", normalizeUUSDCString(batch.MaxRelayTxFeeUusdc.String))
+			}
+			fmt.Printf("  Relay Delivered: %s\n", formatNullTime(batch.RelayDeliveredAt))
+			if batch.RelayCostUusdc.Valid {
+				fmt.Printf("  Relay Cost: %s USDC\n", normalizeUUSDCString(batch.RelayCostUusdc.String))
+			}
+			fmt.Printf("  Completed: %s\n", formatNullTime(batch.CompletedAt))
+			if batch.CompletedAt.Valid {
+				fmt.Printf("  Time To Complete: %s\n", batch.CompletedAt.Time.Sub(batch.CreatedAt).Round(time.Second))
+			}
+		}
+	},
+}
+
+func normalizeUUSDCString(amount string) string {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return amount
+	}
+	return normalizeBalance(value, CCTP_TOKEN_DECIMALS)
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return "-"
+	}
+	return t.Time.Format(time.RFC3339)
+}
+
+func init() {
+	rootCmd.AddCommand(settlementBatchesCmd)
+	settlementBatchesCmd.Flags().Int64("limit", 20, "Number of most recent settlement batches to show")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/skip-mev/go-fast-solver/shared/keys"
 	"github.com/skip-mev/go-fast-solver/shared/lmt"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
+	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -30,6 +31,12 @@ var settlementsCmd = &cobra.Command{
 	Example: "solver settlements",
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := setupContext(cmd)
+
+		database, err := setupDatabase(ctx, cmd)
+		if err != nil {
+			lmt.Logger(ctx).Fatal("Failed to setup database", zap.Error(err))
+		}
+
 		keysPath, err := cmd.Flags().GetString("keys")
 		if err != nil {
 			lmt.Logger(ctx).Fatal("Error reading keys path", zap.Error(err))
@@ -46,9 +53,10 @@ var settlementsCmd = &cobra.Command{
 		}
 
 		cosmosTxExecutor := cosmos.DefaultSerializedCosmosTxExecutor()
-		cctpClientManager := clientmanager.NewClientManager(keyStore, cosmosTxExecutor)
+		evmTxExecutor := evm.DefaultEVMTxExecutor()
+		cctpClientManager := clientmanager.NewClientManager(keyStore, cosmosTxExecutor, evmTxExecutor)
 
-		pendingSettlements, err := ordersettler.DetectPendingSettlements(ctx, cctpClientManager, nil)
+		pendingSettlements, err := ordersettler.DetectPendingSettlements(ctx, cctpClientManager, database)
 		if err != nil {
 			lmt.Logger(ctx).Fatal("Failed to get pending settlements", zap.Error(err))
 		}
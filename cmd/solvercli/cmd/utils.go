@@ -11,6 +11,7 @@ import (
 	"github.com/skip-mev/go-fast-solver/shared/keys"
 	"github.com/skip-mev/go-fast-solver/shared/lmt"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
+	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"math/big"
@@ -73,7 +74,8 @@ func setupClients(ctx context.Context, cmd *cobra.Command) (evmrpc.EVMRPCClientM
 	}
 
 	cosmosTxExecutor := cosmos.DefaultSerializedCosmosTxExecutor()
-	return evmClientManager, clientmanager.NewClientManager(keyStore, cosmosTxExecutor)
+	evmTxExecutor := evm.DefaultEVMTxExecutor()
+	return evmClientManager, clientmanager.NewClientManager(keyStore, cosmosTxExecutor, evmTxExecutor)
 }
 
 func normalizeBalance(balance *big.Int, decimals uint8) string {
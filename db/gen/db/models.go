@@ -60,6 +60,9 @@ type OrderSettlement struct {
 	CompleteSettlementTx              sql.NullString
 	SettlementStatus                  string
 	SettlementStatusMessage           sql.NullString
+	SettlementTrigger                 sql.NullString
+	SettlementBatchID                 sql.NullInt64
+	InitiateSettlementFailures        int64
 }
 
 type RebalanceTransfer struct {
@@ -73,6 +76,27 @@ type RebalanceTransfer struct {
 	Status             string
 }
 
+type SettlementBatch struct {
+	ID                      int64
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	SourceChainID           string
+	DestinationChainID      string
+	InitiateSettlementTx    string
+	SettlementTrigger       string
+	NumOrders               int64
+	TotalValue              string
+	TotalProfit             string
+	MaxRelayTxFeeUusdc      sql.NullString
+	RelayCostUusdc          sql.NullString
+	InitiatedAt             sql.NullTime
+	RelaySubmittedAt        sql.NullTime
+	RelayDeliveredAt        sql.NullTime
+	CompletedAt             sql.NullTime
+	SettlementStatus        string
+	SettlementStatusMessage sql.NullString
+}
+
 type SubmittedTx struct {
 	ID                  int64
 	CreatedAt           time.Time
@@ -90,6 +114,15 @@ type SubmittedTx struct {
 	RebalanceTransferID sql.NullInt64
 }
 
+type TransferMonitorBlockHash struct {
+	ID        int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ChainID   string
+	Height    int64
+	BlockHash string
+}
+
 type TransferMonitorMetadatum struct {
 	ID             int64
 	CreatedAt      time.Time
@@ -97,3 +130,13 @@ type TransferMonitorMetadatum struct {
 	ChainID        string
 	HeightLastSeen int64
 }
+
+type UnsettleableOrderFill struct {
+	ID                     int64
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+	ChainID                string
+	GatewayContractAddress string
+	OrderID                string
+	Reason                 string
+}
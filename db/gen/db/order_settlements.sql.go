@@ -10,8 +10,54 @@ import (
 	"database/sql"
 )
 
+const clearInitiateSettlementTx = `-- name: ClearInitiateSettlementTx :one
+UPDATE order_settlements
+SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = NULL, settlement_trigger = NULL, settlement_batch_id = NULL,
+    initiate_settlement_failures = initiate_settlement_failures + 1, settlement_status = ?, settlement_status_message = ?
+WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
+    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures
+`
+
+type ClearInitiateSettlementTxParams struct {
+	SettlementStatus                  string
+	SettlementStatusMessage           sql.NullString
+	SourceChainID                     string
+	OrderID                           string
+	SourceChainGatewayContractAddress string
+}
+
+func (q *Queries) ClearInitiateSettlementTx(ctx context.Context, arg ClearInitiateSettlementTxParams) (OrderSettlement, error) {
+	row := q.db.QueryRowContext(ctx, clearInitiateSettlementTx,
+		arg.SettlementStatus,
+		arg.SettlementStatusMessage,
+		arg.SourceChainID,
+		arg.OrderID,
+		arg.SourceChainGatewayContractAddress,
+	)
+	var i OrderSettlement
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SourceChainID,
+		&i.DestinationChainID,
+		&i.SourceChainGatewayContractAddress,
+		&i.Amount,
+		&i.Profit,
+		&i.OrderID,
+		&i.InitiateSettlementTx,
+		&i.CompleteSettlementTx,
+		&i.SettlementStatus,
+		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
+	)
+	return i, err
+}
+
 const getAllOrderSettlementsWithSettlementStatus = `-- name: GetAllOrderSettlementsWithSettlementStatus :many
-SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message FROM order_settlements WHERE settlement_status = ?
+SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures FROM order_settlements WHERE settlement_status = ?
 `
 
 func (q *Queries) GetAllOrderSettlementsWithSettlementStatus(ctx context.Context, settlementStatus string) ([]OrderSettlement, error) {
@@ -37,6 +83,9 @@ func (q *Queries) GetAllOrderSettlementsWithSettlementStatus(ctx context.Context
 			&i.CompleteSettlementTx,
 			&i.SettlementStatus,
 			&i.SettlementStatusMessage,
+			&i.SettlementTrigger,
+			&i.SettlementBatchID,
+			&i.InitiateSettlementFailures,
 		); err != nil {
 			return nil, err
 		}
@@ -52,7 +101,7 @@ func (q *Queries) GetAllOrderSettlementsWithSettlementStatus(ctx context.Context
 }
 
 const getOrderSettlement = `-- name: GetOrderSettlement :one
-SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message FROM order_settlements WHERE source_chain_id = ? AND source_chain_gateway_contract_address = ? AND order_id = ?
+SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures FROM order_settlements WHERE source_chain_id = ? AND source_chain_gateway_contract_address = ? AND order_id = ?
 `
 
 type GetOrderSettlementParams struct {
@@ -78,10 +127,106 @@ func (q *Queries) GetOrderSettlement(ctx context.Context, arg GetOrderSettlement
 		&i.CompleteSettlementTx,
 		&i.SettlementStatus,
 		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
 	)
 	return i, err
 }
 
+const getOrderSettlementsBySettlementBatchID = `-- name: GetOrderSettlementsBySettlementBatchID :many
+SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures FROM order_settlements WHERE settlement_batch_id = ?
+`
+
+func (q *Queries) GetOrderSettlementsBySettlementBatchID(ctx context.Context, settlementBatchID sql.NullInt64) ([]OrderSettlement, error) {
+	rows, err := q.db.QueryContext(ctx, getOrderSettlementsBySettlementBatchID, settlementBatchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderSettlement
+	for rows.Next() {
+		var i OrderSettlement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.SourceChainGatewayContractAddress,
+			&i.Amount,
+			&i.Profit,
+			&i.OrderID,
+			&i.InitiateSettlementTx,
+			&i.CompleteSettlementTx,
+			&i.SettlementStatus,
+			&i.SettlementStatusMessage,
+			&i.SettlementTrigger,
+			&i.SettlementBatchID,
+			&i.InitiateSettlementFailures,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrderSettlementsWithFailedInitiateSettlementTx = `-- name: GetOrderSettlementsWithFailedInitiateSettlementTx :many
+SELECT DISTINCT order_settlements.id, order_settlements.created_at, order_settlements.updated_at, order_settlements.source_chain_id, order_settlements.destination_chain_id, order_settlements.source_chain_gateway_contract_address, order_settlements.amount, order_settlements.profit, order_settlements.order_id, order_settlements.initiate_settlement_tx, order_settlements.complete_settlement_tx, order_settlements.settlement_status, order_settlements.settlement_status_message, order_settlements.settlement_trigger, order_settlements.settlement_batch_id, order_settlements.initiate_settlement_failures FROM order_settlements
+INNER JOIN submitted_txs ON submitted_txs.chain_id = order_settlements.destination_chain_id
+    AND submitted_txs.tx_hash = order_settlements.initiate_settlement_tx
+WHERE submitted_txs.tx_type = 'SETTLEMENT'
+    AND submitted_txs.tx_status IN ('FAILED', 'ABANDONED')
+    AND order_settlements.settlement_status IN ('PENDING', 'SETTLEMENT_INITIATED', 'FAILED')
+`
+
+func (q *Queries) GetOrderSettlementsWithFailedInitiateSettlementTx(ctx context.Context) ([]OrderSettlement, error) {
+	rows, err := q.db.QueryContext(ctx, getOrderSettlementsWithFailedInitiateSettlementTx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrderSettlement
+	for rows.Next() {
+		var i OrderSettlement
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.SourceChainGatewayContractAddress,
+			&i.Amount,
+			&i.Profit,
+			&i.OrderID,
+			&i.InitiateSettlementTx,
+			&i.CompleteSettlementTx,
+			&i.SettlementStatus,
+			&i.SettlementStatusMessage,
+			&i.SettlementTrigger,
+			&i.SettlementBatchID,
+			&i.InitiateSettlementFailures,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertOrderSettlement = `-- name: InsertOrderSettlement :one
 INSERT INTO order_settlements (
     source_chain_id,
@@ -91,7 +236,7 @@ INSERT INTO order_settlements (
     profit,
     order_id,
     settlement_status
-) VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message
+) VALUES (?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures
 `
 
 type InsertOrderSettlementParams struct {
@@ -129,6 +274,9 @@ func (q *Queries) InsertOrderSettlement(ctx context.Context, arg InsertOrderSett
 		&i.CompleteSettlementTx,
 		&i.SettlementStatus,
 		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
 	)
 	return i, err
 }
@@ -137,7 +285,7 @@ const setCompleteSettlementTx = `-- name: SetCompleteSettlementTx :one
 UPDATE order_settlements
 SET updated_at=CURRENT_TIMESTAMP, complete_settlement_tx = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
-    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message
+    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures
 `
 
 type SetCompleteSettlementTxParams struct {
@@ -169,19 +317,24 @@ func (q *Queries) SetCompleteSettlementTx(ctx context.Context, arg SetCompleteSe
 		&i.CompleteSettlementTx,
 		&i.SettlementStatus,
 		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
 	)
 	return i, err
 }
 
 const setInitiateSettlementTx = `-- name: SetInitiateSettlementTx :one
 UPDATE order_settlements
-SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = ?
+SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = ?, settlement_trigger = ?, settlement_batch_id = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
-    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message
+    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures
 `
 
 type SetInitiateSettlementTxParams struct {
 	InitiateSettlementTx              sql.NullString
+	SettlementTrigger                 sql.NullString
+	SettlementBatchID                 sql.NullInt64
 	SourceChainID                     string
 	OrderID                           string
 	SourceChainGatewayContractAddress string
@@ -190,6 +343,8 @@ type SetInitiateSettlementTxParams struct {
 func (q *Queries) SetInitiateSettlementTx(ctx context.Context, arg SetInitiateSettlementTxParams) (OrderSettlement, error) {
 	row := q.db.QueryRowContext(ctx, setInitiateSettlementTx,
 		arg.InitiateSettlementTx,
+		arg.SettlementTrigger,
+		arg.SettlementBatchID,
 		arg.SourceChainID,
 		arg.OrderID,
 		arg.SourceChainGatewayContractAddress,
@@ -209,6 +364,9 @@ func (q *Queries) SetInitiateSettlementTx(ctx context.Context, arg SetInitiateSe
 		&i.CompleteSettlementTx,
 		&i.SettlementStatus,
 		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
 	)
 	return i, err
 }
@@ -217,7 +375,7 @@ const setSettlementStatus = `-- name: SetSettlementStatus :one
 UPDATE order_settlements
 SET updated_at=CURRENT_TIMESTAMP, settlement_status = ?, settlement_status_message = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
-    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message
+    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, amount, profit, order_id, initiate_settlement_tx, complete_settlement_tx, settlement_status, settlement_status_message, settlement_trigger, settlement_batch_id, initiate_settlement_failures
 `
 
 type SetSettlementStatusParams struct {
@@ -251,6 +409,9 @@ func (q *Queries) SetSettlementStatus(ctx context.Context, arg SetSettlementStat
 		&i.CompleteSettlementTx,
 		&i.SettlementStatus,
 		&i.SettlementStatusMessage,
+		&i.SettlementTrigger,
+		&i.SettlementBatchID,
+		&i.InitiateSettlementFailures,
 	)
 	return i, err
 }
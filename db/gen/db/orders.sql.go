@@ -93,6 +93,248 @@ func (q *Queries) GetOrderByOrderID(ctx context.Context, orderID string) (Order,
 	return i, err
 }
 
+const getOrderFillsByFillerPage = `-- name: GetOrderFillsByFillerPage :many
+SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, sender, recipient, amount_in, amount_out, nonce, order_id, timeout_timestamp, order_creation_tx, order_creation_tx_block_height, data, filler, fill_tx, refund_tx, order_status, order_status_message FROM orders
+WHERE destination_chain_id = ? AND order_status = 'FILLED' AND lower(filler) = lower(?) AND order_id > ?
+ORDER BY order_id
+LIMIT ?
+`
+
+type GetOrderFillsByFillerPageParams struct {
+	DestinationChainID string
+	Filler             string
+	OrderID            string
+	Limit              int64
+}
+
+func (q *Queries) GetOrderFillsByFillerPage(ctx context.Context, arg GetOrderFillsByFillerPageParams) ([]Order, error) {
+	rows, err := q.db.QueryContext(ctx, getOrderFillsByFillerPage,
+		arg.DestinationChainID,
+		arg.Filler,
+		arg.OrderID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.SourceChainGatewayContractAddress,
+			&i.Sender,
+			&i.Recipient,
+			&i.AmountIn,
+			&i.AmountOut,
+			&i.Nonce,
+			&i.OrderID,
+			&i.TimeoutTimestamp,
+			&i.OrderCreationTx,
+			&i.OrderCreationTxBlockHeight,
+			&i.Data,
+			&i.Filler,
+			&i.FillTx,
+			&i.RefundTx,
+			&i.OrderStatus,
+			&i.OrderStatusMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrdersInSourceChainBlockRange = `-- name: GetOrdersInSourceChainBlockRange :many
+SELECT id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, sender, recipient, amount_in, amount_out, nonce, order_id, timeout_timestamp, order_creation_tx, order_creation_tx_block_height, data, filler, fill_tx, refund_tx, order_status, order_status_message FROM orders WHERE source_chain_id = ? AND order_creation_tx_block_height >= ? AND order_creation_tx_block_height <= ?
+`
+
+type GetOrdersInSourceChainBlockRangeParams struct {
+	SourceChainID                string
+	OrderCreationTxBlockHeight   int64
+	OrderCreationTxBlockHeight_2 int64
+}
+
+func (q *Queries) GetOrdersInSourceChainBlockRange(ctx context.Context, arg GetOrdersInSourceChainBlockRangeParams) ([]Order, error) {
+	rows, err := q.db.QueryContext(ctx, getOrdersInSourceChainBlockRange, arg.SourceChainID, arg.OrderCreationTxBlockHeight, arg.OrderCreationTxBlockHeight_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.SourceChainGatewayContractAddress,
+			&i.Sender,
+			&i.Recipient,
+			&i.AmountIn,
+			&i.AmountOut,
+			&i.Nonce,
+			&i.OrderID,
+			&i.TimeoutTimestamp,
+			&i.OrderCreationTx,
+			&i.OrderCreationTxBlockHeight,
+			&i.Data,
+			&i.Filler,
+			&i.FillTx,
+			&i.RefundTx,
+			&i.OrderStatus,
+			&i.OrderStatusMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnprocessedOrderFillsPage = `-- name: GetUnprocessedOrderFillsPage :many
+SELECT orders.id, orders.created_at, orders.updated_at, orders.source_chain_id, orders.destination_chain_id, orders.source_chain_gateway_contract_address, orders.sender, orders.recipient, orders.amount_in, orders.amount_out, orders.nonce, orders.order_id, orders.timeout_timestamp, orders.order_creation_tx, orders.order_creation_tx_block_height, orders.data, orders.filler, orders.fill_tx, orders.refund_tx, orders.order_status, orders.order_status_message FROM orders
+LEFT JOIN order_settlements ON orders.source_chain_id = order_settlements.source_chain_id
+    AND orders.source_chain_gateway_contract_address = order_settlements.source_chain_gateway_contract_address
+    AND orders.order_id = order_settlements.order_id
+LEFT JOIN unsettleable_order_fills ON orders.destination_chain_id = unsettleable_order_fills.chain_id
+    AND unsettleable_order_fills.gateway_contract_address = ?
+    AND orders.order_id = unsettleable_order_fills.order_id
+WHERE orders.destination_chain_id = ? AND orders.order_status = 'FILLED' AND lower(orders.filler) = lower(?)
+    AND order_settlements.id IS NULL AND unsettleable_order_fills.id IS NULL AND orders.id > ?
+ORDER BY orders.id
+LIMIT ?
+`
+
+type GetUnprocessedOrderFillsPageParams struct {
+	GatewayContractAddress string
+	DestinationChainID     string
+	Filler                 string
+	ID                     int64
+	Limit                  int64
+}
+
+func (q *Queries) GetUnprocessedOrderFillsPage(ctx context.Context, arg GetUnprocessedOrderFillsPageParams) ([]Order, error) {
+	rows, err := q.db.QueryContext(ctx, getUnprocessedOrderFillsPage,
+		arg.GatewayContractAddress,
+		arg.DestinationChainID,
+		arg.Filler,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Order
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.SourceChainGatewayContractAddress,
+			&i.Sender,
+			&i.Recipient,
+			&i.AmountIn,
+			&i.AmountOut,
+			&i.Nonce,
+			&i.OrderID,
+			&i.TimeoutTimestamp,
+			&i.OrderCreationTx,
+			&i.OrderCreationTxBlockHeight,
+			&i.Data,
+			&i.Filler,
+			&i.FillTx,
+			&i.RefundTx,
+			&i.OrderStatus,
+			&i.OrderStatusMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnsettledOrderFills = `-- name: GetUnsettledOrderFills :many
+SELECT orders.id, orders.source_chain_id, orders.destination_chain_id, orders.amount_out
+FROM orders
+LEFT JOIN order_settlements ON orders.source_chain_id = order_settlements.source_chain_id
+    AND orders.source_chain_gateway_contract_address = order_settlements.source_chain_gateway_contract_address
+    AND orders.order_id = order_settlements.order_id
+WHERE orders.destination_chain_id = ? AND orders.order_status = 'FILLED' AND lower(orders.filler) = lower(?)
+    AND (order_settlements.id IS NULL OR order_settlements.settlement_status IN ('PENDING', 'SETTLEMENT_INITIATED', 'FAILED'))
+`
+
+type GetUnsettledOrderFillsParams struct {
+	DestinationChainID string
+	Filler             string
+}
+
+type GetUnsettledOrderFillsRow struct {
+	ID                 int64
+	SourceChainID      string
+	DestinationChainID string
+	AmountOut          string
+}
+
+func (q *Queries) GetUnsettledOrderFills(ctx context.Context, arg GetUnsettledOrderFillsParams) ([]GetUnsettledOrderFillsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUnsettledOrderFills, arg.DestinationChainID, arg.Filler)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUnsettledOrderFillsRow
+	for rows.Next() {
+		var i GetUnsettledOrderFillsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.SourceChainID,
+			&i.DestinationChainID,
+			&i.AmountOut,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertOrder = `-- name: InsertOrder :one
 INSERT INTO orders (
     source_chain_id,
@@ -108,8 +350,9 @@ INSERT INTO orders (
     order_creation_tx_block_height,
     order_id,
     order_status,
+    order_status_message,
     timeout_timestamp
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, sender, recipient, amount_in, amount_out, nonce, order_id, timeout_timestamp, order_creation_tx, order_creation_tx_block_height, data, filler, fill_tx, refund_tx, order_status, order_status_message
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, sender, recipient, amount_in, amount_out, nonce, order_id, timeout_timestamp, order_creation_tx, order_creation_tx_block_height, data, filler, fill_tx, refund_tx, order_status, order_status_message
 `
 
 type InsertOrderParams struct {
@@ -126,6 +369,7 @@ type InsertOrderParams struct {
 	OrderCreationTxBlockHeight        int64
 	OrderID                           string
 	OrderStatus                       string
+	OrderStatusMessage                sql.NullString
 	TimeoutTimestamp                  time.Time
 }
 
@@ -144,6 +388,7 @@ func (q *Queries) InsertOrder(ctx context.Context, arg InsertOrderParams) (Order
 		arg.OrderCreationTxBlockHeight,
 		arg.OrderID,
 		arg.OrderStatus,
+		arg.OrderStatusMessage,
 		arg.TimeoutTimestamp,
 	)
 	var i Order
@@ -225,6 +470,56 @@ func (q *Queries) SetFillTx(ctx context.Context, arg SetFillTxParams) (Order, er
 	return i, err
 }
 
+const setOrderCreationTx = `-- name: SetOrderCreationTx :one
+UPDATE orders
+SET updated_at=CURRENT_TIMESTAMP, order_creation_tx = ?, order_creation_tx_block_height = ?
+WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
+    RETURNING id, created_at, updated_at, source_chain_id, destination_chain_id, source_chain_gateway_contract_address, sender, recipient, amount_in, amount_out, nonce, order_id, timeout_timestamp, order_creation_tx, order_creation_tx_block_height, data, filler, fill_tx, refund_tx, order_status, order_status_message
+`
+
+type SetOrderCreationTxParams struct {
+	OrderCreationTx                   string
+	OrderCreationTxBlockHeight        int64
+	SourceChainID                     string
+	OrderID                           string
+	SourceChainGatewayContractAddress string
+}
+
+func (q *Queries) SetOrderCreationTx(ctx context.Context, arg SetOrderCreationTxParams) (Order, error) {
+	row := q.db.QueryRowContext(ctx, setOrderCreationTx,
+		arg.OrderCreationTx,
+		arg.OrderCreationTxBlockHeight,
+		arg.SourceChainID,
+		arg.OrderID,
+		arg.SourceChainGatewayContractAddress,
+	)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SourceChainID,
+		&i.DestinationChainID,
+		&i.SourceChainGatewayContractAddress,
+		&i.Sender,
+		&i.Recipient,
+		&i.AmountIn,
+		&i.AmountOut,
+		&i.Nonce,
+		&i.OrderID,
+		&i.TimeoutTimestamp,
+		&i.OrderCreationTx,
+		&i.OrderCreationTxBlockHeight,
+		&i.Data,
+		&i.Filler,
+		&i.FillTx,
+		&i.RefundTx,
+		&i.OrderStatus,
+		&i.OrderStatusMessage,
+	)
+	return i, err
+}
+
 const setOrderStatus = `-- name: SetOrderStatus :one
 UPDATE orders
 SET updated_at=CURRENT_TIMESTAMP, order_status = ?, order_status_message = ?
@@ -10,32 +10,54 @@ import (
 )
 
 type Querier interface {
+	ClearInitiateSettlementTx(ctx context.Context, arg ClearInitiateSettlementTxParams) (OrderSettlement, error)
+	DeleteTransferMonitorBlockHashesBelowHeight(ctx context.Context, arg DeleteTransferMonitorBlockHashesBelowHeightParams) error
 	GetAllHyperlaneTransfersWithTransferStatus(ctx context.Context, transferStatus string) ([]HyperlaneTransfer, error)
 	GetAllOrderSettlementsWithSettlementStatus(ctx context.Context, settlementStatus string) ([]OrderSettlement, error)
 	GetAllOrdersWithOrderStatus(ctx context.Context, orderStatus string) ([]Order, error)
 	GetAllPendingRebalanceTransfers(ctx context.Context) ([]GetAllPendingRebalanceTransfersRow, error)
+	GetAllSettlementBatchesWithSettlementStatus(ctx context.Context, settlementStatus string) ([]SettlementBatch, error)
 	GetAllSubmittedTxs(ctx context.Context) ([]SubmittedTx, error)
 	GetHyperlaneTransferByMessageSentTx(ctx context.Context, arg GetHyperlaneTransferByMessageSentTxParams) (HyperlaneTransfer, error)
 	GetOrderByOrderID(ctx context.Context, orderID string) (Order, error)
+	GetOrderFillsByFillerPage(ctx context.Context, arg GetOrderFillsByFillerPageParams) ([]Order, error)
 	GetOrderSettlement(ctx context.Context, arg GetOrderSettlementParams) (OrderSettlement, error)
+	GetOrderSettlementsBySettlementBatchID(ctx context.Context, settlementBatchID sql.NullInt64) ([]OrderSettlement, error)
+	GetOrderSettlementsWithFailedInitiateSettlementTx(ctx context.Context) ([]OrderSettlement, error)
+	GetOrdersInSourceChainBlockRange(ctx context.Context, arg GetOrdersInSourceChainBlockRangeParams) ([]Order, error)
 	GetPendingRebalanceTransfersToChain(ctx context.Context, destinationChainID string) ([]GetPendingRebalanceTransfersToChainRow, error)
+	GetRecentSettlementBatches(ctx context.Context, limit int64) ([]SettlementBatch, error)
+	GetSettlementBatch(ctx context.Context, id int64) (SettlementBatch, error)
 	GetSubmittedTxsByHyperlaneTransferId(ctx context.Context, hyperlaneTransferID sql.NullInt64) ([]SubmittedTx, error)
 	GetSubmittedTxsByOrderIdAndType(ctx context.Context, arg GetSubmittedTxsByOrderIdAndTypeParams) ([]SubmittedTx, error)
 	GetSubmittedTxsByOrderStatusAndType(ctx context.Context, arg GetSubmittedTxsByOrderStatusAndTypeParams) ([]SubmittedTx, error)
 	GetSubmittedTxsWithStatus(ctx context.Context, txStatus string) ([]SubmittedTx, error)
+	GetTransferMonitorBlockHashesInRange(ctx context.Context, arg GetTransferMonitorBlockHashesInRangeParams) ([]TransferMonitorBlockHash, error)
 	GetTransferMonitorMetadata(ctx context.Context, chainID string) (TransferMonitorMetadatum, error)
+	GetUnprocessedOrderFillsPage(ctx context.Context, arg GetUnprocessedOrderFillsPageParams) ([]Order, error)
+	GetUnsettleableOrderFill(ctx context.Context, arg GetUnsettleableOrderFillParams) (UnsettleableOrderFill, error)
+	GetUnsettledOrderFills(ctx context.Context, arg GetUnsettledOrderFillsParams) ([]GetUnsettledOrderFillsRow, error)
 	InsertHyperlaneTransfer(ctx context.Context, arg InsertHyperlaneTransferParams) (HyperlaneTransfer, error)
 	InsertOrder(ctx context.Context, arg InsertOrderParams) (Order, error)
 	InsertOrderSettlement(ctx context.Context, arg InsertOrderSettlementParams) (OrderSettlement, error)
 	InsertRebalanceTransfer(ctx context.Context, arg InsertRebalanceTransferParams) (int64, error)
+	InsertSettlementBatch(ctx context.Context, arg InsertSettlementBatchParams) (SettlementBatch, error)
 	InsertSubmittedTx(ctx context.Context, arg InsertSubmittedTxParams) (SubmittedTx, error)
+	InsertTransferMonitorBlockHash(ctx context.Context, arg InsertTransferMonitorBlockHashParams) (TransferMonitorBlockHash, error)
 	InsertTransferMonitorMetadata(ctx context.Context, arg InsertTransferMonitorMetadataParams) (TransferMonitorMetadatum, error)
+	InsertUnsettleableOrderFill(ctx context.Context, arg InsertUnsettleableOrderFillParams) error
 	SetCompleteSettlementTx(ctx context.Context, arg SetCompleteSettlementTxParams) (OrderSettlement, error)
 	SetFillTx(ctx context.Context, arg SetFillTxParams) (Order, error)
 	SetInitiateSettlementTx(ctx context.Context, arg SetInitiateSettlementTxParams) (OrderSettlement, error)
 	SetMessageStatus(ctx context.Context, arg SetMessageStatusParams) (HyperlaneTransfer, error)
+	SetOrderCreationTx(ctx context.Context, arg SetOrderCreationTxParams) (Order, error)
 	SetOrderStatus(ctx context.Context, arg SetOrderStatusParams) (Order, error)
 	SetRefundTx(ctx context.Context, arg SetRefundTxParams) (Order, error)
+	SetSettlementBatchCompleted(ctx context.Context, arg SetSettlementBatchCompletedParams) (SettlementBatch, error)
+	SetSettlementBatchInitiated(ctx context.Context, arg SetSettlementBatchInitiatedParams) (SettlementBatch, error)
+	SetSettlementBatchRelayDelivered(ctx context.Context, arg SetSettlementBatchRelayDeliveredParams) (SettlementBatch, error)
+	SetSettlementBatchRelaySubmitted(ctx context.Context, arg SetSettlementBatchRelaySubmittedParams) error
+	SetSettlementBatchStatus(ctx context.Context, arg SetSettlementBatchStatusParams) (SettlementBatch, error)
 	SetSettlementStatus(ctx context.Context, arg SetSettlementStatusParams) (OrderSettlement, error)
 	SetSubmittedTxStatus(ctx context.Context, arg SetSubmittedTxStatusParams) (SubmittedTx, error)
 	UpdateTransferStatus(ctx context.Context, arg UpdateTransferStatusParams) error
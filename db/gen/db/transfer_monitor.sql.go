@@ -9,6 +9,60 @@ import (
 	"context"
 )
 
+const deleteTransferMonitorBlockHashesBelowHeight = `-- name: DeleteTransferMonitorBlockHashesBelowHeight :exec
+DELETE FROM transfer_monitor_block_hashes WHERE chain_id = ? AND height < ?
+`
+
+type DeleteTransferMonitorBlockHashesBelowHeightParams struct {
+	ChainID string
+	Height  int64
+}
+
+func (q *Queries) DeleteTransferMonitorBlockHashesBelowHeight(ctx context.Context, arg DeleteTransferMonitorBlockHashesBelowHeightParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTransferMonitorBlockHashesBelowHeight, arg.ChainID, arg.Height)
+	return err
+}
+
+const getTransferMonitorBlockHashesInRange = `-- name: GetTransferMonitorBlockHashesInRange :many
+SELECT id, created_at, updated_at, chain_id, height, block_hash FROM transfer_monitor_block_hashes WHERE chain_id = ? AND height >= ? AND height <= ? ORDER BY height
+`
+
+type GetTransferMonitorBlockHashesInRangeParams struct {
+	ChainID  string
+	Height   int64
+	Height_2 int64
+}
+
+func (q *Queries) GetTransferMonitorBlockHashesInRange(ctx context.Context, arg GetTransferMonitorBlockHashesInRangeParams) ([]TransferMonitorBlockHash, error) {
+	rows, err := q.db.QueryContext(ctx, getTransferMonitorBlockHashesInRange, arg.ChainID, arg.Height, arg.Height_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransferMonitorBlockHash
+	for rows.Next() {
+		var i TransferMonitorBlockHash
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ChainID,
+			&i.Height,
+			&i.BlockHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransferMonitorMetadata = `-- name: GetTransferMonitorMetadata :one
 SELECT id, created_at, updated_at, chain_id, height_last_seen FROM transfer_monitor_metadata WHERE chain_id = ?
 `
@@ -26,6 +80,30 @@ func (q *Queries) GetTransferMonitorMetadata(ctx context.Context, chainID string
 	return i, err
 }
 
+const insertTransferMonitorBlockHash = `-- name: InsertTransferMonitorBlockHash :one
+INSERT INTO transfer_monitor_block_hashes (chain_id, height, block_hash) VALUES (?, ?, ?) ON CONFLICT (chain_id, height) DO UPDATE SET block_hash = excluded.block_hash, updated_at=CURRENT_TIMESTAMP RETURNING id, created_at, updated_at, chain_id, height, block_hash
+`
+
+type InsertTransferMonitorBlockHashParams struct {
+	ChainID   string
+	Height    int64
+	BlockHash string
+}
+
+func (q *Queries) InsertTransferMonitorBlockHash(ctx context.Context, arg InsertTransferMonitorBlockHashParams) (TransferMonitorBlockHash, error) {
+	row := q.db.QueryRowContext(ctx, insertTransferMonitorBlockHash, arg.ChainID, arg.Height, arg.BlockHash)
+	var i TransferMonitorBlockHash
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ChainID,
+		&i.Height,
+		&i.BlockHash,
+	)
+	return i, err
+}
+
 const insertTransferMonitorMetadata = `-- name: InsertTransferMonitorMetadata :one
 INSERT INTO transfer_monitor_metadata (chain_id, height_last_seen) VALUES (?, ?) ON CONFLICT (chain_id) DO UPDATE SET height_last_seen = excluded.height_last_seen, updated_at=CURRENT_TIMESTAMP RETURNING id, created_at, updated_at, chain_id, height_last_seen
 `
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS transfer_monitor_block_hashes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    chain_id TEXT NOT NULL,
+    height BIGINT NOT NULL,
+    block_hash TEXT NOT NULL,
+    UNIQUE(chain_id, height)
+);
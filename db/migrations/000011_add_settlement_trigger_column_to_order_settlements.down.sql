@@ -0,0 +1 @@
+ALTER TABLE order_settlements DROP COLUMN settlement_trigger;
@@ -0,0 +1,3 @@
+ALTER TABLE order_settlements DROP COLUMN settlement_batch_id;
+
+DROP TABLE IF EXISTS settlement_batches;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS settlement_batches (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    source_chain_id      TEXT NOT NULL,
+    destination_chain_id TEXT NOT NULL,
+    initiate_settlement_tx TEXT NOT NULL,
+    settlement_trigger TEXT NOT NULL,
+    num_orders   INT NOT NULL,
+    total_value  TEXT NOT NULL,
+    total_profit TEXT NOT NULL,
+
+    max_relay_tx_fee_uusdc TEXT,
+    relay_cost_uusdc       TEXT,
+
+    initiated_at       TIMESTAMP,
+    relay_submitted_at TIMESTAMP,
+    relay_delivered_at TIMESTAMP,
+    completed_at       TIMESTAMP,
+
+    settlement_status         TEXT NOT NULL,
+    settlement_status_message TEXT,
+
+    UNIQUE(destination_chain_id, initiate_settlement_tx)
+);
+
+ALTER TABLE order_settlements ADD COLUMN settlement_batch_id INT REFERENCES settlement_batches(id);
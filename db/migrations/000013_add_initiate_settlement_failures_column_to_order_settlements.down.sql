@@ -0,0 +1 @@
+ALTER TABLE order_settlements DROP COLUMN initiate_settlement_failures;
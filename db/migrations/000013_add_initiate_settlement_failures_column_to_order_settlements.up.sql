@@ -0,0 +1 @@
+ALTER TABLE order_settlements ADD COLUMN initiate_settlement_failures INT NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS order_fill_scan_cursors (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    chain_id TEXT NOT NULL,
+    gateway_contract_address TEXT NOT NULL,
+    last_order_id TEXT,
+    UNIQUE(chain_id, gateway_contract_address)
+);
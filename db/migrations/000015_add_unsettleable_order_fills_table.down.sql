@@ -0,0 +1 @@
+DROP TABLE IF EXISTS unsettleable_order_fills;
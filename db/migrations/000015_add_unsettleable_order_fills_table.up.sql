@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS unsettleable_order_fills (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    chain_id TEXT NOT NULL,
+    gateway_contract_address TEXT NOT NULL,
+    order_id TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    UNIQUE(chain_id, gateway_contract_address, order_id)
+);
@@ -12,12 +12,23 @@ INSERT INTO order_settlements (
 -- name: GetAllOrderSettlementsWithSettlementStatus :many
 SELECT * FROM order_settlements WHERE settlement_status = ?;
 
+-- name: GetOrderSettlementsBySettlementBatchID :many
+SELECT * FROM order_settlements WHERE settlement_batch_id = ?;
+
+-- name: GetOrderSettlementsWithFailedInitiateSettlementTx :many
+SELECT DISTINCT order_settlements.* FROM order_settlements
+INNER JOIN submitted_txs ON submitted_txs.chain_id = order_settlements.destination_chain_id
+    AND submitted_txs.tx_hash = order_settlements.initiate_settlement_tx
+WHERE submitted_txs.tx_type = 'SETTLEMENT'
+    AND submitted_txs.tx_status IN ('FAILED', 'ABANDONED')
+    AND order_settlements.settlement_status IN ('PENDING', 'SETTLEMENT_INITIATED', 'FAILED');
+
 -- name: GetOrderSettlement :one
 SELECT * FROM order_settlements WHERE source_chain_id = ? AND source_chain_gateway_contract_address = ? AND order_id = ?;
 
 -- name: SetInitiateSettlementTx :one
 UPDATE order_settlements
-SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = ?
+SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = ?, settlement_trigger = ?, settlement_batch_id = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
     RETURNING *;
 
@@ -31,4 +42,11 @@ WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_add
 UPDATE order_settlements
 SET updated_at=CURRENT_TIMESTAMP, settlement_status = ?, settlement_status_message = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
-    RETURNING *;
\ No newline at end of file
+    RETURNING *;
+
+-- name: ClearInitiateSettlementTx :one
+UPDATE order_settlements
+SET updated_at=CURRENT_TIMESTAMP, initiate_settlement_tx = NULL, settlement_trigger = NULL, settlement_batch_id = NULL,
+    initiate_settlement_failures = initiate_settlement_failures + 1, settlement_status = ?, settlement_status_message = ?
+WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
+    RETURNING *;
@@ -13,8 +13,9 @@ INSERT INTO orders (
     order_creation_tx_block_height,
     order_id,
     order_status,
+    order_status_message,
     timeout_timestamp
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING *;
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT DO NOTHING RETURNING *;
 
 -- name: GetAllOrdersWithOrderStatus :many
 SELECT * FROM orders WHERE order_status = ?;
@@ -25,6 +26,12 @@ SET updated_at=CURRENT_TIMESTAMP, fill_tx = ?, filler = ?, order_status = ?
 WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
     RETURNING *;
 
+-- name: SetOrderCreationTx :one
+UPDATE orders
+SET updated_at=CURRENT_TIMESTAMP, order_creation_tx = ?, order_creation_tx_block_height = ?
+WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_address = ?
+    RETURNING *;
+
 -- name: SetOrderStatus :one
 UPDATE orders
 SET updated_at=CURRENT_TIMESTAMP, order_status = ?, order_status_message = ?
@@ -39,3 +46,34 @@ WHERE source_chain_id = ? AND order_id = ? AND source_chain_gateway_contract_add
 
 -- name: GetOrderByOrderID :one
 SELECT * FROM orders WHERE order_id = ?;
+
+-- name: GetOrdersInSourceChainBlockRange :many
+SELECT * FROM orders WHERE source_chain_id = ? AND order_creation_tx_block_height >= ? AND order_creation_tx_block_height <= ?;
+
+-- name: GetOrderFillsByFillerPage :many
+SELECT * FROM orders
+WHERE destination_chain_id = ? AND order_status = 'FILLED' AND lower(filler) = lower(?) AND order_id > ?
+ORDER BY order_id
+LIMIT ?;
+
+-- name: GetUnprocessedOrderFillsPage :many
+SELECT orders.* FROM orders
+LEFT JOIN order_settlements ON orders.source_chain_id = order_settlements.source_chain_id
+    AND orders.source_chain_gateway_contract_address = order_settlements.source_chain_gateway_contract_address
+    AND orders.order_id = order_settlements.order_id
+LEFT JOIN unsettleable_order_fills ON orders.destination_chain_id = unsettleable_order_fills.chain_id
+    AND unsettleable_order_fills.gateway_contract_address = ?
+    AND orders.order_id = unsettleable_order_fills.order_id
+WHERE orders.destination_chain_id = ? AND orders.order_status = 'FILLED' AND lower(orders.filler) = lower(?)
+    AND order_settlements.id IS NULL AND unsettleable_order_fills.id IS NULL AND orders.id > ?
+ORDER BY orders.id
+LIMIT ?;
+
+-- name: GetUnsettledOrderFills :many
+SELECT orders.id, orders.source_chain_id, orders.destination_chain_id, orders.amount_out
+FROM orders
+LEFT JOIN order_settlements ON orders.source_chain_id = order_settlements.source_chain_id
+    AND orders.source_chain_gateway_contract_address = order_settlements.source_chain_gateway_contract_address
+    AND orders.order_id = order_settlements.order_id
+WHERE orders.destination_chain_id = ? AND orders.order_status = 'FILLED' AND lower(orders.filler) = lower(?)
+    AND (order_settlements.id IS NULL OR order_settlements.settlement_status IN ('PENDING', 'SETTLEMENT_INITIATED', 'FAILED'));
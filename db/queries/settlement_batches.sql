@@ -0,0 +1,49 @@
+-- name: InsertSettlementBatch :one
+INSERT INTO settlement_batches (
+    source_chain_id,
+    destination_chain_id,
+    initiate_settlement_tx,
+    settlement_trigger,
+    num_orders,
+    total_value,
+    total_profit,
+    settlement_status
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING *;
+
+-- name: GetSettlementBatch :one
+SELECT * FROM settlement_batches WHERE id = ?;
+
+-- name: GetAllSettlementBatchesWithSettlementStatus :many
+SELECT * FROM settlement_batches WHERE settlement_status = ?;
+
+-- name: GetRecentSettlementBatches :many
+SELECT * FROM settlement_batches ORDER BY id DESC LIMIT ?;
+
+-- name: SetSettlementBatchStatus :one
+UPDATE settlement_batches
+SET updated_at=CURRENT_TIMESTAMP, settlement_status = ?, settlement_status_message = ?
+WHERE id = ?
+    RETURNING *;
+
+-- name: SetSettlementBatchInitiated :one
+UPDATE settlement_batches
+SET updated_at=CURRENT_TIMESTAMP, settlement_status = ?, initiated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+    RETURNING *;
+
+-- name: SetSettlementBatchCompleted :one
+UPDATE settlement_batches
+SET updated_at=CURRENT_TIMESTAMP, settlement_status = ?, completed_at = CURRENT_TIMESTAMP
+WHERE id = ?
+    RETURNING *;
+
+-- name: SetSettlementBatchRelaySubmitted :exec
+UPDATE settlement_batches
+SET updated_at=CURRENT_TIMESTAMP, max_relay_tx_fee_uusdc = ?, relay_submitted_at = CURRENT_TIMESTAMP
+WHERE id = ? AND relay_submitted_at IS NULL;
+
+-- name: SetSettlementBatchRelayDelivered :one
+UPDATE settlement_batches
+SET updated_at=CURRENT_TIMESTAMP, relay_cost_uusdc = ?, relay_delivered_at = ?
+WHERE id = ?
+    RETURNING *;
@@ -3,4 +3,12 @@ INSERT INTO transfer_monitor_metadata (chain_id, height_last_seen) VALUES (?, ?)
 
 
 -- name: GetTransferMonitorMetadata :one
-SELECT * FROM transfer_monitor_metadata WHERE chain_id = ?;
\ No newline at end of file
+SELECT * FROM transfer_monitor_metadata WHERE chain_id = ?;
+-- name: InsertTransferMonitorBlockHash :one
+INSERT INTO transfer_monitor_block_hashes (chain_id, height, block_hash) VALUES (?, ?, ?) ON CONFLICT (chain_id, height) DO UPDATE SET block_hash = excluded.block_hash, updated_at=CURRENT_TIMESTAMP RETURNING *;
+
+-- name: GetTransferMonitorBlockHashesInRange :many
+SELECT * FROM transfer_monitor_block_hashes WHERE chain_id = ? AND height >= ? AND height <= ? ORDER BY height;
+
+-- name: DeleteTransferMonitorBlockHashesBelowHeight :exec
+DELETE FROM transfer_monitor_block_hashes WHERE chain_id = ? AND height < ?;
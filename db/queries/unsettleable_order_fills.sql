@@ -0,0 +1,5 @@
+-- name: GetUnsettleableOrderFill :one
+SELECT * FROM unsettleable_order_fills WHERE chain_id = ? AND gateway_contract_address = ? AND order_id = ?;
+
+-- name: InsertUnsettleableOrderFill :exec
+INSERT INTO unsettleable_order_fills (chain_id, gateway_contract_address, order_id, reason) VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING;
@@ -6,12 +6,19 @@ const (
 	OrderStatusExpiredPendingRefund string = "EXPIRED_PENDING_REFUND"
 	OrderStatusRefunded             string = "REFUNDED"
 	OrderStatusAbandoned            string = "ABANDONED"
+	OrderStatusUnsupportedRoute     string = "UNSUPPORTED_ROUTE"
+	OrderStatusReorged              string = "REORGED"
 
 	SettlementStatusPending             string = "PENDING"
 	SettlementStatusSettlementInitiated string = "SETTLEMENT_INITIATED"
 	SettlementStatusComplete            string = "COMPLETE"
 	SettlementStatusFailed              string = "FAILED"
 
+	SettlementTriggerValueThreshold string = "VALUE_THRESHOLD"
+	SettlementTriggerMaxAge         string = "MAX_AGE"
+	SettlementTriggerMaxBatchCount  string = "MAX_BATCH_COUNT"
+	SettlementTriggerCapitalNeed    string = "CAPITAL_NEED"
+
 	TxStatusPending   string = "PENDING"
 	TxStatusSuccess   string = "SUCCESS"
 	TxStatusFailed    string = "FAILED"
@@ -0,0 +1,211 @@
+package exposure
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/inventory"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"github.com/skip-mev/go-fast-solver/shared/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	metricsInterval = 30 * time.Second
+
+	CapTypeRoute       = "route"
+	CapTypeSourceChain = "source_chain"
+	CapTypeGlobal      = "global"
+)
+
+type Database interface {
+	GetUnsettledOrderFills(ctx context.Context, arg db.GetUnsettledOrderFillsParams) ([]db.GetUnsettledOrderFillsRow, error)
+}
+
+type Route struct {
+	SourceChainID      string
+	DestinationChainID string
+}
+
+// Exposure is the amount of uusdc the solver has fronted for orders that has
+// not yet been settled back to it
+type Exposure struct {
+	Total         *big.Int
+	BySourceChain map[string]*big.Int
+	ByRoute       map[Route]*big.Int
+
+	// orders are the db ids of the orders included in the exposure
+	orders map[int64]bool
+}
+
+func newExposure() Exposure {
+	return Exposure{
+		Total:         big.NewInt(0),
+		BySourceChain: make(map[string]*big.Int),
+		ByRoute:       make(map[Route]*big.Int),
+		orders:        make(map[int64]bool),
+	}
+}
+
+func (e Exposure) add(orderID int64, route Route, amount *big.Int) {
+	if e.orders[orderID] {
+		return
+	}
+	e.orders[orderID] = true
+
+	e.Total.Add(e.Total, amount)
+	if _, ok := e.BySourceChain[route.SourceChainID]; !ok {
+		e.BySourceChain[route.SourceChainID] = big.NewInt(0)
+	}
+	e.BySourceChain[route.SourceChainID].Add(e.BySourceChain[route.SourceChainID], amount)
+	if _, ok := e.ByRoute[route]; !ok {
+		e.ByRoute[route] = big.NewInt(0)
+	}
+	e.ByRoute[route].Add(e.ByRoute[route], amount)
+}
+
+// exposureCap is a configured cap on the exposure of a scope (i.e. a route)
+type exposureCap struct {
+	capType  string
+	cap      string
+	exposure *big.Int
+	scope    string
+}
+
+// Tracker tracks the solvers unsettled exposure, made up of filled orders
+// whose settlement has not completed and order fills that are in flight, and
+// checks it against the configured exposure caps
+type Tracker struct {
+	db     Database
+	ledger *inventory.Ledger
+}
+
+func NewTracker(db Database, ledger *inventory.Ledger) *Tracker {
+	return &Tracker{
+		db:     db,
+		ledger: ledger,
+	}
+}
+
+// Exposure calculates the solvers current unsettled exposure. Only orders
+// filled by the solvers own address on their destination chain are counted,
+// since orders filled by other solvers are never settled to this solver.
+func (t *Tracker) Exposure(ctx context.Context) (Exposure, error) {
+	exposure := newExposure()
+	for _, chainType := range []config.ChainType{config.ChainType_COSMOS, config.ChainType_EVM} {
+		chains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(chainType)
+		if err != nil {
+			return Exposure{}, fmt.Errorf("getting %s chains: %w", chainType, err)
+		}
+		for _, chain := range chains {
+			fills, err := t.db.GetUnsettledOrderFills(ctx, db.GetUnsettledOrderFillsParams{
+				DestinationChainID: chain.ChainID,
+				Filler:             chain.SolverAddress,
+			})
+			if err != nil {
+				return Exposure{}, fmt.Errorf("getting unsettled order fills on chainID %s: %w", chain.ChainID, err)
+			}
+
+			for _, fill := range fills {
+				amount, ok := new(big.Int).SetString(fill.AmountOut, 10)
+				if !ok {
+					return Exposure{}, fmt.Errorf("parsing amount out %s of order with id %d", fill.AmountOut, fill.ID)
+				}
+				exposure.add(fill.ID, Route{SourceChainID: fill.SourceChainID, DestinationChainID: fill.DestinationChainID}, amount)
+			}
+		}
+	}
+
+	// in flight fills are reserved in the inventory ledger until their fill
+	// tx lands, orders that have already been marked as filled are skipped
+	for _, reservation := range t.ledger.Reservations() {
+		exposure.add(reservation.OrderID, Route{SourceChainID: reservation.SourceChainID, DestinationChainID: reservation.ChainID}, reservation.Amount)
+	}
+	return exposure, nil
+}
+
+// CheckFill checks if filling an order would take the solvers unsettled
+// exposure over the configured route, source chain or global cap. If it
+// would, the type of cap and the reason are returned.
+func (t *Tracker) CheckFill(ctx context.Context, order db.Order) (allowed bool, capType string, reason string, err error) {
+	amount, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return false, "", "", fmt.Errorf("parsing amount out %s", order.AmountOut)
+	}
+
+	exposure, err := t.Exposure(ctx)
+	if err != nil {
+		return false, "", "", err
+	}
+	route := Route{SourceChainID: order.SourceChainID, DestinationChainID: order.DestinationChainID}
+	exposure.add(order.ID, route, amount)
+
+	routeConfig, err := config.GetConfigReader(ctx).GetRouteConfig(order.SourceChainID, order.DestinationChainID)
+	if err != nil {
+		return false, "", "", fmt.Errorf("getting route config: %w", err)
+	}
+	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
+	if err != nil {
+		return false, "", "", fmt.Errorf("getting source chain config: %w", err)
+	}
+
+	caps := []exposureCap{
+		{CapTypeRoute, routeConfig.MaxUnsettledExposureUUSDC, exposure.ByRoute[route], fmt.Sprintf("route from chain %s to chain %s", order.SourceChainID, order.DestinationChainID)},
+		{CapTypeSourceChain, sourceChainConfig.MaxUnsettledExposureUUSDC, exposure.BySourceChain[order.SourceChainID], fmt.Sprintf("source chain %s", order.SourceChainID)},
+		{CapTypeGlobal, config.GetConfigReader(ctx).Config().OrderFillerConfig.MaxUnsettledExposureUUSDC, exposure.Total, "all chains"},
+	}
+	for _, c := range caps {
+		if c.cap == "" {
+			continue
+		}
+		capAmount, ok := new(big.Int).SetString(c.cap, 10)
+		if !ok {
+			return false, "", "", fmt.Errorf("parsing %s max unsettled exposure %s", c.capType, c.cap)
+		}
+		if c.exposure.Cmp(capAmount) > 0 {
+			return false, c.capType, fmt.Sprintf(
+				"unsettled exposure of %suusdc on %s would exceed cap of %suusdc",
+				c.exposure.String(), c.scope, capAmount.String(),
+			), nil
+		}
+	}
+	return true, "", "", nil
+}
+
+// Start periodically exports the solvers unsettled exposure per route as a
+// metric
+func (t *Tracker) Start(ctx context.Context) error {
+	lmt.Logger(ctx).Info("Starting exposure tracker")
+
+	reportedRoutes := make(map[Route]bool)
+	ticker := time.NewTicker(metricsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			exposure, err := t.Exposure(ctx)
+			if err != nil {
+				lmt.Logger(ctx).Error("failed to calculate unsettled exposure", zap.Error(err))
+				continue
+			}
+
+			// routes that were previously reported but no longer have any
+			// exposure are reset to 0
+			for route := range reportedRoutes {
+				if _, ok := exposure.ByRoute[route]; !ok {
+					metrics.FromContext(ctx).SetUnsettledExposure(route.SourceChainID, route.DestinationChainID, *big.NewInt(0))
+				}
+			}
+			for route, amount := range exposure.ByRoute {
+				metrics.FromContext(ctx).SetUnsettledExposure(route.SourceChainID, route.DestinationChainID, *amount)
+				reportedRoutes[route] = true
+			}
+		}
+	}
+}
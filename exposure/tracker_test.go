@@ -0,0 +1,166 @@
+package exposure
+
+import (
+	"context"
+	"database/sql"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/connect"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/inventory"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDatabase struct {
+	fills []db.GetUnsettledOrderFillsRow
+}
+
+func (f fakeDatabase) GetUnsettledOrderFills(ctx context.Context, arg db.GetUnsettledOrderFillsParams) ([]db.GetUnsettledOrderFillsRow, error) {
+	var fills []db.GetUnsettledOrderFillsRow
+	for _, fill := range f.fills {
+		if fill.DestinationChainID == arg.DestinationChainID {
+			fills = append(fills, fill)
+		}
+	}
+	return fills, nil
+}
+
+func TestCheckFill(t *testing.T) {
+	ctx := config.ConfigReaderContext(context.Background(), config.NewConfigReader(config.Config{
+		OrderFillerConfig: config.OrderFillerConfig{MaxUnsettledExposureUUSDC: "1000"},
+		Chains: map[string]config.ChainConfig{
+			"1": {
+				ChainID:                   "1",
+				Type:                      config.ChainType_EVM,
+				MaxUnsettledExposureUUSDC: "600",
+				Routes: map[string]config.RouteConfig{
+					"osmosis-1": {MaxUnsettledExposureUUSDC: "300"},
+				},
+			},
+			"10":        {ChainID: "10", Type: config.ChainType_EVM},
+			"osmosis-1": {ChainID: "osmosis-1", Type: config.ChainType_COSMOS},
+		},
+	}))
+
+	ledger := inventory.NewLedger()
+	tracker := NewTracker(fakeDatabase{fills: []db.GetUnsettledOrderFillsRow{
+		{ID: 1, SourceChainID: "1", DestinationChainID: "osmosis-1", AmountOut: "200"},
+		{ID: 2, SourceChainID: "1", DestinationChainID: "10", AmountOut: "200"},
+		{ID: 3, SourceChainID: "10", DestinationChainID: "1", AmountOut: "300"},
+	}}, ledger)
+
+	// in flight fills count towards exposure, but orders that are already
+	// filled are not counted twice
+	ledger.Reserve(1, "1", "osmosis-1", "uusdc", big.NewInt(200), big.NewInt(10000))
+	ledger.Reserve(4, "1", "osmosis-1", "uusdc", big.NewInt(50), big.NewInt(10000))
+	exposure, err := tracker.Exposure(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(750), exposure.Total)
+	assert.Equal(t, big.NewInt(450), exposure.BySourceChain["1"])
+	assert.Equal(t, big.NewInt(250), exposure.ByRoute[Route{SourceChainID: "1", DestinationChainID: "osmosis-1"}])
+
+	allowed, _, _, err := tracker.CheckFill(ctx, db.Order{ID: 5, SourceChainID: "1", DestinationChainID: "osmosis-1", AmountOut: "50"})
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, capType, _, err := tracker.CheckFill(ctx, db.Order{ID: 5, SourceChainID: "1", DestinationChainID: "osmosis-1", AmountOut: "51"})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, CapTypeRoute, capType)
+
+	allowed, capType, _, err = tracker.CheckFill(ctx, db.Order{ID: 5, SourceChainID: "1", DestinationChainID: "10", AmountOut: "151"})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, CapTypeSourceChain, capType)
+
+	allowed, capType, _, err = tracker.CheckFill(ctx, db.Order{ID: 5, SourceChainID: "10", DestinationChainID: "1", AmountOut: "251"})
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, CapTypeGlobal, capType)
+}
+
+func TestExposureIncludesFailedSettlements(t *testing.T) {
+	ctx := config.ConfigReaderContext(context.Background(), config.NewConfigReader(config.Config{
+		Chains: map[string]config.ChainConfig{
+			"osmosis-1": {ChainID: "osmosis-1", Type: config.ChainType_COSMOS, SolverAddress: "osmo1solver"},
+		},
+	}))
+	conn, err := connect.ConnectAndMigrate(ctx, filepath.Join(t.TempDir(), "solver.db"), "../db/migrations")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	database := db.New(conn)
+
+	// fills are the filler and settlement status of filled orders by order
+	// id, an empty status meaning the order has no settlement yet
+	fills := map[string]struct {
+		filler           string
+		settlementStatus string
+	}{
+		"a": {filler: "osmo1solver"},
+		"b": {filler: "osmo1solver", settlementStatus: dbtypes.SettlementStatusPending},
+		"c": {filler: "osmo1solver", settlementStatus: dbtypes.SettlementStatusSettlementInitiated},
+		"d": {filler: "osmo1solver", settlementStatus: dbtypes.SettlementStatusFailed},
+		"e": {filler: "osmo1solver", settlementStatus: dbtypes.SettlementStatusComplete},
+		// filled by another solver so will never be settled to this solver
+		"f": {filler: "osmo1othersolver"},
+	}
+	for orderID, fill := range fills {
+		_, err := database.InsertOrder(ctx, db.InsertOrderParams{
+			SourceChainID:                     "1",
+			DestinationChainID:                "osmosis-1",
+			SourceChainGatewayContractAddress: "0xgateway",
+			Sender:                            []byte("sender"),
+			Recipient:                         []byte("recipient"),
+			AmountIn:                          "110",
+			AmountOut:                         "100",
+			OrderCreationTx:                   "tx" + orderID,
+			OrderID:                           orderID,
+			OrderStatus:                       dbtypes.OrderStatusFilled,
+			TimeoutTimestamp:                  time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		_, err = database.SetFillTx(ctx, db.SetFillTxParams{
+			FillTx:                            sql.NullString{String: "filltx" + orderID, Valid: true},
+			Filler:                            sql.NullString{String: fill.filler, Valid: true},
+			OrderStatus:                       dbtypes.OrderStatusFilled,
+			SourceChainID:                     "1",
+			OrderID:                           orderID,
+			SourceChainGatewayContractAddress: "0xgateway",
+		})
+		require.NoError(t, err)
+		if fill.settlementStatus == "" {
+			continue
+		}
+
+		_, err = database.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
+			SourceChainID:                     "1",
+			DestinationChainID:                "osmosis-1",
+			SourceChainGatewayContractAddress: "0xgateway",
+			Amount:                            "110",
+			Profit:                            "10",
+			OrderID:                           orderID,
+			SettlementStatus:                  dbtypes.SettlementStatusPending,
+		})
+		require.NoError(t, err)
+		_, err = database.SetSettlementStatus(ctx, db.SetSettlementStatusParams{
+			SettlementStatus:                  fill.settlementStatus,
+			SettlementStatusMessage:           sql.NullString{},
+			SourceChainID:                     "1",
+			OrderID:                           orderID,
+			SourceChainGatewayContractAddress: "0xgateway",
+		})
+		require.NoError(t, err)
+	}
+
+	// every fill by the solver except the one whose settlement completed is
+	// unsettled, including the fill whose settlement failed
+	exposure, err := NewTracker(database, inventory.NewLedger()).Exposure(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(400), exposure.Total)
+}
@@ -45,6 +45,12 @@ func NewCheckpointFetcherFromStorageLocation(storageLocation string, validator s
 	if strings.HasPrefix(storageLocation, "s3://") {
 		return NewS3Fetcher(storageLocation, validator)
 	}
+	if strings.HasPrefix(storageLocation, "gs://") {
+		return NewGCSFetcher(storageLocation, validator)
+	}
+	if strings.HasPrefix(storageLocation, "https://") {
+		return NewHTTPFetcher(storageLocation, validator)
+	}
 	return nil, fmt.Errorf("no fetcher type found for storage location %s", storageLocation)
 }
 
@@ -93,46 +99,45 @@ func (f *LocalFileFetcher) Validator() string {
 	return f.validator
 }
 
-type S3Fetcher struct {
+// HTTPFetcher fetches checkpoints that a validator has written to object
+// storage that is readable over http, using the same object layout as the
+// hyperlane validators s3 and gcs checkpoint syncers
+type HTTPFetcher struct {
 	url       string
 	validator string
 	client    *http.Client
+
+	// forbiddenIsNotFound treats a 403 response as the object not existing.
+	// gcs returns a 403 instead of a 404 for objects that do not exist in
+	// public buckets that do not grant list permissions.
+	forbiddenIsNotFound bool
 }
 
-func NewS3Fetcher(storageLocation string, validator string) (*S3Fetcher, error) {
-	locationString := strings.TrimPrefix(storageLocation, "s3://")
-	locationSplit := strings.Split(locationString, "/")
-	if len(locationSplit) < 2 {
-		return nil, fmt.Errorf("invalid s3 storage location %s", storageLocation)
+func NewHTTPFetcher(storageLocation string, validator string) (*HTTPFetcher, error) {
+	u, err := url.Parse(storageLocation)
+	if err != nil {
+		return nil, fmt.Errorf("parsing storage location %s: %w", storageLocation, err)
 	}
-	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", locationSplit[0], locationSplit[1])
-	if len(locationSplit) > 2 {
-		url += "/" + strings.Join(locationSplit[2:], "/")
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("invalid http storage location %s", storageLocation)
 	}
-	return &S3Fetcher{url: url, validator: validator, client: http.DefaultClient}, nil
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid http storage location %s, no host", storageLocation)
+	}
+	return &HTTPFetcher{url: strings.TrimSuffix(storageLocation, "/"), validator: validator, client: http.DefaultClient}, nil
 }
 
-func (f *S3Fetcher) LatestIndex(ctx context.Context) (uint64, error) {
+func (f *HTTPFetcher) LatestIndex(ctx context.Context) (uint64, error) {
 	u, err := url.JoinPath(f.url, latestIndexFilePathS3)
 	if err != nil {
 		return 0, fmt.Errorf("joining base url %s and path %s: %w", f.url, latestIndexFilePathS3, err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return 0, fmt.Errorf("creating request: %w", err)
-	}
-
-	resp, err := f.client.Do(req)
+	body, err := f.get(ctx, u)
 	if err != nil {
-		return 0, fmt.Errorf("performing request: %w", err)
+		return 0, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return 0, fmt.Errorf("reading response body: %w", err)
-	}
 	var index uint64
 	if err = json.Unmarshal(body, &index); err != nil {
 		return 0, fmt.Errorf("unmarshaling latest index file %s contents: %w", u, err)
@@ -140,12 +145,35 @@ func (f *S3Fetcher) LatestIndex(ctx context.Context) (uint64, error) {
 	return index, nil
 }
 
-func (f *S3Fetcher) Checkpoint(ctx context.Context, index uint64) (*types.SignedCheckpoint, error) {
+func (f *HTTPFetcher) Checkpoint(ctx context.Context, index uint64) (*types.SignedCheckpoint, error) {
 	u, err := url.JoinPath(f.url, checkpointFilePathS3(index))
 	if err != nil {
 		return nil, fmt.Errorf("joining base url %s and path %s: %w", f.url, checkpointFilePathS3(index), err)
 	}
 
+	body, err := f.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoint types.SignedCheckpoint
+	if err = json.Unmarshal(body, &checkpoint); err != nil {
+		return nil, fmt.Errorf("unmarshaling checkpoint file %s contents: %w", u, err)
+	}
+	// we do this because for some reason the validator strips leading and trailing 0s from the R and S values when it serializes
+	// the checkpoint and puts it in s3
+	serializedSignature := strings.TrimPrefix(checkpoint.SerializedSignature, "0x")
+	if len(serializedSignature) < 128 {
+		return nil, fmt.Errorf("invalid serialized signature length %d in checkpoint file %s", len(serializedSignature), u)
+	}
+	checkpoint.Signature.R = serializedSignature[:64]
+	checkpoint.Signature.S = serializedSignature[64:128]
+	return &checkpoint, nil
+}
+
+// get fetches the object at u, returning ErrCheckpointDoesNotExist if the
+// object is not found
+func (f *HTTPFetcher) get(ctx context.Context, u string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
@@ -157,27 +185,59 @@ func (f *S3Fetcher) Checkpoint(ctx context.Context, index uint64) (*types.Signed
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
+		if resp.StatusCode == http.StatusNotFound || (f.forbiddenIsNotFound && resp.StatusCode == http.StatusForbidden) {
 			return nil, ErrCheckpointDoesNotExist
 		}
-		return nil, fmt.Errorf("unexpected status code %d fetching s3 checkpoint from %s", resp.StatusCode, u)
+		return nil, fmt.Errorf("unexpected status code %d fetching checkpoint object from %s", resp.StatusCode, u)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("reading response body: %w", err)
 	}
-	var checkpoint types.SignedCheckpoint
-	if err = json.Unmarshal(body, &checkpoint); err != nil {
-		return nil, fmt.Errorf("unmarshaling checkpoint file %s contents: %w", u, err)
-	}
-	// we do this because for some reason the validator strips leading and trailing 0s from the R and S values when it serializes
-	// the checkpoint and puts it in s3
-	checkpoint.Signature.R = strings.TrimPrefix(checkpoint.SerializedSignature, "0x")[:64]
-	checkpoint.Signature.S = strings.TrimPrefix(checkpoint.SerializedSignature, "0x")[64:128]
-	return &checkpoint, nil
+	return body, nil
 }
 
-func (f *S3Fetcher) Validator() string {
+func (f *HTTPFetcher) Validator() string {
 	return f.validator
 }
+
+type S3Fetcher struct {
+	*HTTPFetcher
+}
+
+func NewS3Fetcher(storageLocation string, validator string) (*S3Fetcher, error) {
+	locationString := strings.TrimPrefix(storageLocation, "s3://")
+	locationSplit := strings.Split(locationString, "/")
+	if len(locationSplit) < 2 {
+		return nil, fmt.Errorf("invalid s3 storage location %s", storageLocation)
+	}
+	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", locationSplit[0], locationSplit[1])
+	if len(locationSplit) > 2 {
+		url += "/" + strings.Join(locationSplit[2:], "/")
+	}
+	return &S3Fetcher{&HTTPFetcher{url: url, validator: validator, client: http.DefaultClient}}, nil
+}
+
+const (
+	gcsBaseURL = "https://storage.googleapis.com"
+)
+
+type GCSFetcher struct {
+	*HTTPFetcher
+}
+
+// NewGCSFetcher creates a fetcher for a validator that writes checkpoints to
+// a public gcs bucket. The storage location is of the form
+// gs://<bucket>/<optional folder>.
+func NewGCSFetcher(storageLocation string, validator string) (*GCSFetcher, error) {
+	locationString := strings.Trim(strings.TrimPrefix(storageLocation, "gs://"), "/")
+	if locationString == "" {
+		return nil, fmt.Errorf("invalid gcs storage location %s", storageLocation)
+	}
+	url, err := url.JoinPath(gcsBaseURL, strings.Split(locationString, "/")...)
+	if err != nil {
+		return nil, fmt.Errorf("creating gcs url for storage location %s: %w", storageLocation, err)
+	}
+	return &GCSFetcher{&HTTPFetcher{url: url, validator: validator, client: http.DefaultClient, forbiddenIsNotFound: true}}, nil
+}
@@ -0,0 +1,142 @@
+package hyperlane
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSerializedSignature = "0x" +
+		"1111111111111111111111111111111111111111111111111111111111111111" +
+		"2222222222222222222222222222222222222222222222222222222222222222" +
+		"1b"
+	testCheckpoint = `{
+		"value": {
+			"checkpoint": {
+				"merkle_tree_hook_address": "0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"mailbox_domain": 1,
+				"root": "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				"index": 5
+			},
+			"message_id": "0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+		},
+		"signature": {"r": "0x11", "s": "0x22", "v": 27},
+		"serialized_signature": "` + testSerializedSignature + `"
+	}`
+)
+
+func newTestCheckpointServer(t *testing.T, prefix string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case prefix + "/checkpoint_latest_index.json":
+			_, _ = w.Write([]byte("5"))
+		case prefix + "/checkpoint_5_with_id.json":
+			_, _ = w.Write([]byte(testCheckpoint))
+		case prefix + "/checkpoint_6_with_id.json":
+			w.WriteHeader(http.StatusForbidden)
+		case prefix + "/checkpoint_7_with_id.json":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestHTTPFetcher(t *testing.T) {
+	server := newTestCheckpointServer(t, "/validator")
+	defer server.Close()
+
+	fetcher, err := NewHTTPFetcher(server.URL+"/validator/", "0xvalidator")
+	require.NoError(t, err)
+	assert.Equal(t, "0xvalidator", fetcher.Validator())
+
+	ctx := context.Background()
+
+	index, err := fetcher.LatestIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), index)
+
+	checkpoint, err := fetcher.Checkpoint(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), checkpoint.Value.Checkpoint.Index)
+	assert.Equal(t, "0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc", checkpoint.Value.MessageID)
+	assert.Equal(t, strings.Repeat("1", 64), checkpoint.Signature.R)
+	assert.Equal(t, strings.Repeat("2", 64), checkpoint.Signature.S)
+	assert.Equal(t, byte(27), checkpoint.Signature.V)
+
+	_, err = fetcher.Checkpoint(ctx, 4)
+	assert.ErrorIs(t, err, ErrCheckpointDoesNotExist)
+
+	// only gcs returns a 403 for objects that do not exist
+	_, err = fetcher.Checkpoint(ctx, 6)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCheckpointDoesNotExist)
+
+	_, err = fetcher.Checkpoint(ctx, 7)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrCheckpointDoesNotExist)
+}
+
+func TestGCSFetcher(t *testing.T) {
+	server := newTestCheckpointServer(t, "/bucket/folder")
+	defer server.Close()
+
+	fetcher, err := NewCheckpointFetcherFromStorageLocation("gs://bucket/folder", "0xvalidator")
+	require.NoError(t, err)
+	gcsFetcher, ok := fetcher.(*GCSFetcher)
+	require.True(t, ok)
+	assert.Equal(t, "https://storage.googleapis.com/bucket/folder", gcsFetcher.url)
+
+	// point the fetcher at the test server instead of gcs
+	gcsFetcher.url = server.URL + "/bucket/folder"
+
+	ctx := context.Background()
+
+	index, err := fetcher.LatestIndex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), index)
+
+	checkpoint, err := fetcher.Checkpoint(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), checkpoint.Value.Checkpoint.Index)
+
+	_, err = fetcher.Checkpoint(ctx, 4)
+	assert.ErrorIs(t, err, ErrCheckpointDoesNotExist)
+
+	_, err = fetcher.Checkpoint(ctx, 6)
+	assert.ErrorIs(t, err, ErrCheckpointDoesNotExist)
+}
+
+func TestNewCheckpointFetcherFromStorageLocation(t *testing.T) {
+	tests := []struct {
+		name            string
+		storageLocation string
+		expectErr       bool
+	}{
+		{name: "local file", storageLocation: "file:///tmp/validator"},
+		{name: "s3", storageLocation: "s3://bucket/us-east-1/folder"},
+		{name: "gcs", storageLocation: "gs://bucket"},
+		{name: "gcs with folder", storageLocation: "gs://bucket/folder"},
+		{name: "https", storageLocation: "https://checkpoints.example.com/validator"},
+		{name: "invalid gcs", storageLocation: "gs://", expectErr: true},
+		{name: "invalid s3", storageLocation: "s3://bucket", expectErr: true},
+		{name: "unsupported scheme", storageLocation: "ftp://checkpoints.example.com", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCheckpointFetcherFromStorageLocation(tt.storageLocation, "0xvalidator")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
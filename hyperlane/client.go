@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	cosmostxexecutor "github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,14 +15,18 @@ import (
 	"github.com/skip-mev/go-fast-solver/shared/config"
 	"github.com/skip-mev/go-fast-solver/shared/evmrpc"
 	"github.com/skip-mev/go-fast-solver/shared/keys"
+	"github.com/skip-mev/go-fast-solver/shared/oracle"
 )
 
 type Client interface {
 	HasBeenDelivered(ctx context.Context, destinationDomain string, messageID string) (bool, error)
-	ISMType(ctx context.Context, domain string, recipient string) (uint8, error)
-	ValidatorsAndThreshold(ctx context.Context, domain string, recipient string, message string) ([]common.Address, uint8, error)
+	RecipientISM(ctx context.Context, domain string, recipient string) (string, error)
+	ISMType(ctx context.Context, domain string, ismAddress string) (uint8, error)
+	ValidatorsAndThreshold(ctx context.Context, domain string, ismAddress string, message string) ([]common.Address, uint8, error)
+	ModulesAndThreshold(ctx context.Context, domain string, ismAddress string, message string) ([]string, uint8, error)
 	ValidatorStorageLocations(ctx context.Context, domain string, validators []common.Address) ([]*types.ValidatorStorageLocation, error)
 	MerkleTreeLeafCount(ctx context.Context, domain string) (uint64, error)
+	MerkleTreeAtIndex(ctx context.Context, domain, originChainID, initiateTxHash string, index uint64) (*types.MerkleTree, error)
 	Process(ctx context.Context, domain string, message []byte, metadata []byte) ([]byte, string, error)
 	IsContract(ctx context.Context, domain, address string) (bool, error)
 	GetHyperlaneDispatch(ctx context.Context, domain, originChainID, initiateTxHash string) (*types.MailboxDispatchEvent, *types.MailboxMerkleHookPostDispatchEvent, error)
@@ -34,7 +39,14 @@ type MultiClient struct {
 
 // NewMultiClientFromConfig creates a MultiClient that is configured for every
 // chain specific in the config that has a HyperlaneDomain set
-func NewMultiClientFromConfig(ctx context.Context, manager evmrpc.EVMRPCClientManager, keystore keys.KeyStore, evmTxPriceOracle ethereum.TxPriceOracle, evmTxExecutor evm.EVMTxExecutor) (*MultiClient, error) {
+func NewMultiClientFromConfig(
+	ctx context.Context,
+	manager evmrpc.EVMRPCClientManager,
+	keystore keys.KeyStore,
+	txPriceOracle oracle.TxPriceOracle,
+	evmTxExecutor evm.EVMTxExecutor,
+	cosmosTxExecutor cosmostxexecutor.CosmosTxExecutor,
+) (*MultiClient, error) {
 	clients := make(map[string]Client)
 	for _, cfg := range config.GetConfigReader(ctx).Config().Chains {
 		if cfg.HyperlaneDomain == "" {
@@ -43,13 +55,13 @@ func NewMultiClientFromConfig(ctx context.Context, manager evmrpc.EVMRPCClientMa
 
 		switch cfg.Type {
 		case config.ChainType_COSMOS:
-			client, err := cosmos.NewHyperlaneClient(ctx, cfg.HyperlaneDomain)
+			client, err := cosmos.NewHyperlaneClient(ctx, cfg.HyperlaneDomain, keystore, txPriceOracle, cosmosTxExecutor)
 			if err != nil {
 				return nil, fmt.Errorf("creating cosmos hyperlane client for domain %s: %w", cfg.HyperlaneDomain, err)
 			}
 			clients[cfg.HyperlaneDomain] = client
 		case config.ChainType_EVM:
-			client, err := ethereum.NewHyperlaneClient(ctx, cfg.HyperlaneDomain, manager, keystore, evmTxPriceOracle, evmTxExecutor)
+			client, err := ethereum.NewHyperlaneClient(ctx, cfg.HyperlaneDomain, manager, keystore, txPriceOracle, evmTxExecutor)
 			if err != nil {
 				return nil, fmt.Errorf("creating cosmos hyperlane client for domain %s: %w", cfg.HyperlaneDomain, err)
 			}
@@ -81,20 +93,36 @@ func (c *MultiClient) HasBeenDelivered(ctx context.Context, destinationDomain st
 	return client.HasBeenDelivered(ctx, destinationDomain, messageID)
 }
 
-func (c *MultiClient) ISMType(ctx context.Context, domain string, recipient string) (uint8, error) {
+func (c *MultiClient) RecipientISM(ctx context.Context, domain string, recipient string) (string, error) {
+	client, ok := c.clients[domain]
+	if !ok {
+		return "", fmt.Errorf("no configured client for domain %s", domain)
+	}
+	return client.RecipientISM(ctx, domain, recipient)
+}
+
+func (c *MultiClient) ISMType(ctx context.Context, domain string, ismAddress string) (uint8, error) {
 	client, ok := c.clients[domain]
 	if !ok {
 		return 0, fmt.Errorf("no configured client for domain %s", domain)
 	}
-	return client.ISMType(ctx, domain, recipient)
+	return client.ISMType(ctx, domain, ismAddress)
+}
+
+func (c *MultiClient) ValidatorsAndThreshold(ctx context.Context, domain string, ismAddress string, message string) ([]common.Address, uint8, error) {
+	client, ok := c.clients[domain]
+	if !ok {
+		return nil, 0, fmt.Errorf("no configured client for domain %s", domain)
+	}
+	return client.ValidatorsAndThreshold(ctx, domain, ismAddress, message)
 }
 
-func (c *MultiClient) ValidatorsAndThreshold(ctx context.Context, domain string, recipient string, message string) ([]common.Address, uint8, error) {
+func (c *MultiClient) ModulesAndThreshold(ctx context.Context, domain string, ismAddress string, message string) ([]string, uint8, error) {
 	client, ok := c.clients[domain]
 	if !ok {
 		return nil, 0, fmt.Errorf("no configured client for domain %s", domain)
 	}
-	return client.ValidatorsAndThreshold(ctx, domain, recipient, message)
+	return client.ModulesAndThreshold(ctx, domain, ismAddress, message)
 }
 
 func (c *MultiClient) ValidatorStorageLocations(
@@ -117,6 +145,14 @@ func (c *MultiClient) MerkleTreeLeafCount(ctx context.Context, domain string) (u
 	return client.MerkleTreeLeafCount(ctx, domain)
 }
 
+func (c *MultiClient) MerkleTreeAtIndex(ctx context.Context, domain, originChainID, initiateTxHash string, index uint64) (*types.MerkleTree, error) {
+	client, ok := c.clients[domain]
+	if !ok {
+		return nil, fmt.Errorf("no configured client for domain %s", domain)
+	}
+	return client.MerkleTreeAtIndex(ctx, domain, originChainID, initiateTxHash, index)
+}
+
 func (c *MultiClient) Process(ctx context.Context, domain string, message []byte, metadata []byte) ([]byte, string, error) {
 	client, ok := c.clients[domain]
 	if !ok {
@@ -3,33 +3,69 @@ package cosmos
 import (
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"google.golang.org/grpc/credentials"
 
 	"strconv"
 
+	"cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/skip-mev/go-fast-solver/hyperlane/types"
 	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/keys"
+	"github.com/skip-mev/go-fast-solver/shared/signing"
 	"github.com/skip-mev/go-fast-solver/shared/tmrpc"
+	cosmostxexecutor "github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+type TxPriceOracle interface {
+	GasCostUUSDC(ctx context.Context, txFee *big.Int, chainID string) (*big.Int, error)
+}
+
 type HyperlaneClient struct {
 	client                   wasmtypes.QueryClient
+	chainID                  string
 	hyperlaneDomain          string
 	validatorAnnounceAddress string
 	merkleHookAddress        string
+	mailboxAddress           string
+	addressPrefix            string
+	gasPrice                 float64
+	gasDenom                 string
 	tmRPCManager             tmrpc.TendermintRPCClientManager
+
+	txConfig sdkclient.TxConfig
+
+	keystore      keys.KeyStore
+	txPriceOracle TxPriceOracle
+	txExecutor    cosmostxexecutor.CosmosTxExecutor
 }
 
-func NewHyperlaneClient(ctx context.Context, hyperlaneDomain string) (*HyperlaneClient, error) {
+func NewHyperlaneClient(
+	ctx context.Context,
+	hyperlaneDomain string,
+	keystore keys.KeyStore,
+	priceOracle TxPriceOracle,
+	txSubmitter cosmostxexecutor.CosmosTxExecutor,
+) (*HyperlaneClient, error) {
 	chainID, err := config.GetConfigReader(ctx).GetChainIDByHyperlaneDomain(hyperlaneDomain)
 	if err != nil {
 		return nil, fmt.Errorf("getting chainID from hyperlane domain %s: %w", hyperlaneDomain, err)
@@ -52,12 +88,26 @@ func NewHyperlaneClient(ctx context.Context, hyperlaneDomain string) (*Hyperlane
 		return nil, fmt.Errorf("dialing grpc address %s: %w", chainConfig.Cosmos.GRPC, err)
 	}
 
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	wasmtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
 	return &HyperlaneClient{
 		client:                   wasmtypes.NewQueryClient(conn),
+		chainID:                  chainID,
 		hyperlaneDomain:          hyperlaneDomain,
 		validatorAnnounceAddress: chainConfig.Relayer.ValidatorAnnounceContractAddress,
 		merkleHookAddress:        chainConfig.Relayer.MerkleHookContractAddress,
+		mailboxAddress:           chainConfig.Relayer.MailboxAddress,
+		addressPrefix:            chainConfig.Cosmos.AddressPrefix,
+		gasPrice:                 chainConfig.Cosmos.GasPrice,
+		gasDenom:                 chainConfig.Cosmos.GasDenom,
 		tmRPCManager:             tmrpc.NewTendermintRPCClientManager(),
+		txConfig:                 authtx.NewTxConfig(cdc, authtx.DefaultSignModes),
+		keystore:                 keystore,
+		txPriceOracle:            priceOracle,
+		txExecutor:               txSubmitter,
 	}, nil
 }
 
@@ -181,28 +231,107 @@ func (c *HyperlaneClient) HasBeenDelivered(ctx context.Context, domain string, m
 		return false, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
 	}
 
-	panic("not implemented")
+	delivered, err := NewMailboxQuerier(c.mailboxAddress, c.client).MessageDelivered(ctx, strings.TrimPrefix(messageID, "0x"))
+	if err != nil {
+		return false, fmt.Errorf("querying destination mailbox at %s to see if message %s was delivered: %w", c.mailboxAddress, messageID, err)
+	}
+
+	return delivered, nil
+}
+
+// RecipientISM gets the address of the ism that a recipient uses to verify
+// messages. The recipient may be either a bech32 address or a hex encoded
+// 32 byte hyperlane address.
+func (c *HyperlaneClient) RecipientISM(ctx context.Context, domain string, recipient string) (string, error) {
+	if domain != c.hyperlaneDomain {
+		return "", fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	recipientAddress, err := c.toBech32(recipient)
+	if err != nil {
+		return "", fmt.Errorf("converting recipient %s to bech32 address: %w", recipient, err)
+	}
+
+	ismAddress, err := NewMailboxQuerier(c.mailboxAddress, c.client).RecipientISM(ctx, recipientAddress)
+	if err != nil {
+		return "", fmt.Errorf("getting ism address for recipient %s: %w", recipientAddress, err)
+	}
+
+	return ismAddress, nil
 }
 
-func (c *HyperlaneClient) ISMType(ctx context.Context, domain string, recipient string) (uint8, error) {
+func (c *HyperlaneClient) ISMType(ctx context.Context, domain string, ismAddress string) (uint8, error) {
 	if domain != c.hyperlaneDomain {
 		return 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
 	}
 
-	panic("not implemented")
+	ismType, err := NewISMQuerier(ismAddress, c.client).ModuleType(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting ism type for ism address %s: %w", ismAddress, err)
+	}
+
+	return ismType, nil
 }
 
 func (c *HyperlaneClient) ValidatorsAndThreshold(
 	ctx context.Context,
 	domain string,
-	recipient string,
+	ismAddress string,
 	message string,
 ) ([]common.Address, uint8, error) {
 	if domain != c.hyperlaneDomain {
 		return nil, 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
 	}
 
-	panic("not implemented")
+	ismType, err := c.ISMType(ctx, domain, ismAddress)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting ism type for ism %s on domain %s: %w", ismAddress, domain, err)
+	}
+
+	switch ismType {
+	case types.ISMTypeMessageIDMultisig, types.ISMTypeMerkleRootMultisig:
+		validators, threshold, err := NewISMQuerier(ismAddress, c.client).VerifyInfo(ctx, strings.TrimPrefix(message, "0x"))
+		if err != nil {
+			return nil, 0, fmt.Errorf("fetching validators and threshold from multisig ism at address %s: %w", ismAddress, err)
+		}
+		return validators, threshold, nil
+	default:
+		return nil, 0, fmt.Errorf("ism type %d not supported", ismType)
+	}
+}
+
+func (c *HyperlaneClient) ModulesAndThreshold(
+	ctx context.Context,
+	domain string,
+	ismAddress string,
+	message string,
+) ([]string, uint8, error) {
+	if domain != c.hyperlaneDomain {
+		return nil, 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	modules, threshold, err := NewISMQuerier(ismAddress, c.client).ModulesAndThreshold(ctx, strings.TrimPrefix(message, "0x"))
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching modules and threshold from aggregation ism at address %s: %w", ismAddress, err)
+	}
+
+	return modules, threshold, nil
+}
+
+// toBech32 converts a hex encoded hyperlane address into a bech32 address
+// with the chains prefix. If the address is already bech32 it is returned
+// as is.
+func (c *HyperlaneClient) toBech32(address string) (string, error) {
+	if strings.HasPrefix(address, c.addressPrefix+"1") {
+		return address, nil
+	}
+
+	addressBytes, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("decoding hex address %s: %w", address, err)
+	}
+
+	return bech32.ConvertAndEncode(c.addressPrefix, addressBytes)
 }
 
 func (c *HyperlaneClient) ValidatorStorageLocations(
@@ -228,6 +357,105 @@ func (c *HyperlaneClient) ValidatorStorageLocations(
 	return validatorStorageLocations, nil
 }
 
+// MerkleTreeAtIndex reconstructs the state of the origin merkle tree hook
+// directly after the leaf at index was inserted by the dispatch in
+// initiateTxHash. The tree state at the end of the previous block is queried
+// and then all leaves inserted in the dispatch block up to index are
+// inserted.
+func (c *HyperlaneClient) MerkleTreeAtIndex(ctx context.Context, domain, originChainID, initiateTxHash string, index uint64) (*types.MerkleTree, error) {
+	if domain != c.hyperlaneDomain {
+		return nil, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	tmRpcClient, err := c.tmRPCManager.GetClient(ctx, originChainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting tendermint rpc client for chain %s: %w", originChainID, err)
+	}
+	txHashBytes, err := hex.DecodeString(initiateTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("decoding tx hash %s: %w", initiateTxHash, err)
+	}
+	tx, err := tmRpcClient.Tx(ctx, txHashBytes, false)
+	if err != nil {
+		return nil, fmt.Errorf("fetching tx results, hash: %s: %w", initiateTxHash, err)
+	}
+
+	tree, err := NewMerkleTreeHookQuerier(c.merkleHookAddress, c.client).TreeAtHeight(ctx, tx.Height-1)
+	if err != nil {
+		return nil, fmt.Errorf("getting merkle tree at height %d: %w", tx.Height-1, err)
+	}
+	if tree.Count > index {
+		return nil, fmt.Errorf("merkle tree at height %d already has %d leaves, expected leaf %d to be inserted at height %d", tx.Height-1, tree.Count, index, tx.Height)
+	}
+
+	blockResults, err := tmRpcClient.BlockResults(ctx, &tx.Height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching block results at height %d: %w", tx.Height, err)
+	}
+
+	for _, txResult := range blockResults.TxsResults {
+		for _, insertion := range parseMerkleHookInsertions(txResult.Events, c.merkleHookAddress) {
+			if tree.Count > index {
+				break
+			}
+			if insertion.Index != tree.Count {
+				return nil, fmt.Errorf("expected next leaf inserted into tree to have index %d but got %d", tree.Count, insertion.Index)
+			}
+
+			leaf, err := hex.DecodeString(strings.TrimPrefix(insertion.MessageID, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("decoding inserted message id %s: %w", insertion.MessageID, err)
+			}
+			if err := tree.Insert(common.BytesToHash(leaf)); err != nil {
+				return nil, fmt.Errorf("inserting leaf %d into merkle tree: %w", insertion.Index, err)
+			}
+		}
+	}
+	if tree.Count != index+1 {
+		return nil, fmt.Errorf("could not find leaf %d inserted into merkle tree at height %d", index, tx.Height)
+	}
+
+	return tree, nil
+}
+
+// parseMerkleHookInsertions parses all merkle hook post dispatch events
+// emitted by the merkle hook at merkleHookAddress in the order they were
+// emitted
+func parseMerkleHookInsertions(events []abcitypes.Event, merkleHookAddress string) []types.MailboxMerkleHookPostDispatchEvent {
+	const merkleHookPostDispatchEventType = "wasm-hpl_hook_merkle::post_dispatch"
+
+	var insertions []types.MailboxMerkleHookPostDispatchEvent
+	for _, event := range events {
+		if event.Type != merkleHookPostDispatchEventType {
+			continue
+		}
+
+		var d types.MailboxMerkleHookPostDispatchEvent
+		var contractAddress string
+		validIndex := false
+		for _, attribute := range event.Attributes {
+			switch attribute.Key {
+			case "_contract_address":
+				contractAddress = attribute.Value
+			case "message_id":
+				d.MessageID = attribute.Value
+			case "index":
+				idx, err := strconv.ParseUint(attribute.Value, 10, 64)
+				if err != nil {
+					continue
+				}
+				d.Index = idx
+				validIndex = true
+			}
+		}
+		if contractAddress != merkleHookAddress || !validIndex {
+			continue
+		}
+		insertions = append(insertions, d)
+	}
+	return insertions
+}
+
 func (c *HyperlaneClient) MerkleTreeLeafCount(ctx context.Context, domain string) (uint64, error) {
 	if domain != c.hyperlaneDomain {
 		return 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
@@ -235,14 +463,131 @@ func (c *HyperlaneClient) MerkleTreeLeafCount(ctx context.Context, domain string
 	return NewMerkleTreeHookQuerier(c.merkleHookAddress, c.client).Count(ctx)
 }
 
+type ProcessEnvelope struct {
+	Process ProcessMessage `json:"process"`
+}
+
+type ProcessMessage struct {
+	Metadata string `json:"metadata"`
+	Message  string `json:"message"`
+}
+
 func (c *HyperlaneClient) Process(ctx context.Context, domain string, message []byte, metadata []byte) ([]byte, string, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return nil, "", fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	signer, address, err := c.signer(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("getting signer: %w", err)
+	}
+
+	msgs, err := c.processMsgs(address, message, metadata)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating process msgs: %w", err)
+	}
+
+	result, tx, err := c.txExecutor.ExecuteTx(ctx, c.chainID, address, msgs, c.txConfig, signer, c.gasPrice, c.gasDenom)
+	if err != nil {
+		return nil, "", fmt.Errorf("processing message on destination mailbox: %w", err)
+	}
+	if result.Code != 0 {
+		return nil, "", fmt.Errorf("process tx failed with code %d and log: %s", result.Code, result.Log)
+	}
+
+	txBytes, err := c.txConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return nil, "", fmt.Errorf("json encoding process tx: %w", err)
+	}
+
+	return result.Hash, base64.StdEncoding.EncodeToString(txBytes), nil
 }
 
+// QuoteProcessUUSDC simulates processing a message on the destination mailbox
+// and converts the simulated tx fee into uusdc
 func (c *HyperlaneClient) QuoteProcessUUSDC(ctx context.Context, domain string, message []byte, metadata []byte) (*big.Int, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return nil, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	signer, address, err := c.signer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting signer: %w", err)
+	}
+
+	msgs, err := c.processMsgs(address, message, metadata)
+	if err != nil {
+		return nil, fmt.Errorf("creating process msgs: %w", err)
+	}
+
+	gasLimit, err := c.txExecutor.EstimateGasUsed(ctx, c.chainID, address, msgs, c.txConfig, signer)
+	if err != nil {
+		return nil, fmt.Errorf("simulating process tx: %w", err)
+	}
+
+	gasPrice, err := math.LegacyNewDecFromStr(strconv.FormatFloat(c.gasPrice, 'f', -1, 64))
+	if err != nil {
+		return nil, fmt.Errorf("converting gas price %f to decimal: %w", c.gasPrice, err)
+	}
+	txFee := gasPrice.MulInt64(int64(gasLimit)).Ceil().RoundInt().BigInt()
+
+	txFeeUUSDC, err := c.txPriceOracle.GasCostUUSDC(ctx, txFee, c.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting tx fee in uusdc from gas oracle: %w", err)
+	}
+
+	return txFeeUUSDC, nil
 }
 
 func (c *HyperlaneClient) IsContract(ctx context.Context, domain, address string) (bool, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return false, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	contractAddress, err := c.toBech32(address)
+	if err != nil {
+		return false, fmt.Errorf("converting address %s to bech32 address: %w", address, err)
+	}
+
+	_, err = c.client.ContractInfo(ctx, &wasmtypes.QueryContractInfoRequest{Address: contractAddress})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return false, nil
+		}
+		return false, fmt.Errorf("querying contract info for %s: %w", contractAddress, err)
+	}
+
+	return true, nil
+}
+
+func (c *HyperlaneClient) processMsgs(sender string, message []byte, metadata []byte) ([]sdk.Msg, error) {
+	processMsgBytes, err := json.Marshal(ProcessEnvelope{
+		Process: ProcessMessage{
+			Metadata: hex.EncodeToString(metadata),
+			Message:  hex.EncodeToString(message),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshaling process msg: %w", err)
+	}
+
+	return []sdk.Msg{&wasmtypes.MsgExecuteContract{
+		Sender:   sender,
+		Contract: c.mailboxAddress,
+		Msg:      processMsgBytes,
+	}}, nil
+}
+
+func (c *HyperlaneClient) signer(ctx context.Context) (signing.Signer, string, error) {
+	signer, err := signing.NewSigner(ctx, c.chainID, c.keystore)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating signer for chain %s: %w", c.chainID, err)
+	}
+
+	address, err := bech32.ConvertAndEncode(c.addressPrefix, signer.Address())
+	if err != nil {
+		return nil, "", fmt.Errorf("converting signer address to bech32: %w", err)
+	}
+
+	return signer, address, nil
 }
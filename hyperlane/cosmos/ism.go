@@ -0,0 +1,139 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	cosmwasm "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ism types as defined by hyperlane, see
+// https://github.com/hyperlane-xyz/hyperlane-monorepo/blob/main/solidity/contracts/interfaces/IInterchainSecurityModule.sol
+var ismTypes = map[string]uint8{
+	"unused":               0,
+	"routing":              1,
+	"aggregation":          2,
+	"legacy_multisig":      3,
+	"merkle_root_multisig": 4,
+	"message_id_multisig":  5,
+	"null":                 6,
+	"ccip_read":            7,
+}
+
+type ISMQuerier struct {
+	client  cosmwasm.QueryClient
+	address string
+}
+
+func NewISMQuerier(address string, client cosmwasm.QueryClient) *ISMQuerier {
+	return &ISMQuerier{client, address}
+}
+
+type ISMQueryRequest struct {
+	ISM ISMQuery `json:"ism"`
+}
+
+type ISMQuery struct {
+	ModuleType          *struct{}                 `json:"module_type,omitempty"`
+	VerifyInfo          *VerifyInfoQuery          `json:"verify_info,omitempty"`
+	ModulesAndThreshold *ModulesAndThresholdQuery `json:"modules_and_threshold,omitempty"`
+}
+
+type VerifyInfoQuery struct {
+	Message string `json:"message"`
+}
+
+type ModulesAndThresholdQuery struct {
+	Message string `json:"message"`
+}
+
+func (i *ISMQuerier) ModuleType(ctx context.Context) (uint8, error) {
+	req := ISMQueryRequest{
+		ISMQuery{ModuleType: &struct{}{}},
+	}
+
+	type ModuleTypeResponse struct {
+		Type string `json:"typ"`
+	}
+	var resp ModuleTypeResponse
+	if err := i.query(ctx, req, &resp); err != nil {
+		return 0, fmt.Errorf("querying ism module type: %w", err)
+	}
+
+	ismType, ok := ismTypes[resp.Type]
+	if !ok {
+		return 0, fmt.Errorf("unknown ism module type %s", resp.Type)
+	}
+	return ismType, nil
+}
+
+func (i *ISMQuerier) VerifyInfo(ctx context.Context, message string) ([]common.Address, uint8, error) {
+	req := ISMQueryRequest{
+		ISMQuery{VerifyInfo: &VerifyInfoQuery{Message: message}},
+	}
+
+	type VerifyInfoResponse struct {
+		Threshold  uint8    `json:"threshold"`
+		Validators []string `json:"validators"`
+	}
+	var resp VerifyInfoResponse
+	if err := i.query(ctx, req, &resp); err != nil {
+		return nil, 0, fmt.Errorf("querying ism verify info: %w", err)
+	}
+
+	var validators []common.Address
+	for _, validator := range resp.Validators {
+		validatorBytes, err := hex.DecodeString(validator)
+		if err != nil {
+			return nil, 0, fmt.Errorf("decoding validator address %s: %w", validator, err)
+		}
+		validators = append(validators, common.BytesToAddress(validatorBytes))
+	}
+
+	return validators, resp.Threshold, nil
+}
+
+// ModulesAndThreshold queries an aggregation ism for the addresses of its sub
+// modules and how many of them must verify a message
+func (i *ISMQuerier) ModulesAndThreshold(ctx context.Context, message string) ([]string, uint8, error) {
+	req := ISMQueryRequest{
+		ISMQuery{ModulesAndThreshold: &ModulesAndThresholdQuery{Message: message}},
+	}
+
+	type ModulesAndThresholdResponse struct {
+		Threshold uint8    `json:"threshold"`
+		Modules   []string `json:"modules"`
+	}
+	var resp ModulesAndThresholdResponse
+	if err := i.query(ctx, req, &resp); err != nil {
+		return nil, 0, fmt.Errorf("querying ism modules and threshold: %w", err)
+	}
+
+	return resp.Modules, resp.Threshold, nil
+}
+
+func (i *ISMQuerier) query(ctx context.Context, req ISMQueryRequest, out any) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshaling ism query request: %w", err)
+	}
+
+	resp, err := i.client.SmartContractState(ctx, &cosmwasm.QuerySmartContractStateRequest{
+		Address:   i.address,
+		QueryData: data,
+	})
+	if err != nil {
+		return fmt.Errorf("querying ism smart contract %s: %w", i.address, err)
+	}
+	if resp.Data == nil {
+		return fmt.Errorf("got nil response when querying ism")
+	}
+
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		return fmt.Errorf("unmarshaling query bytes into formatted data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	cosmwasm "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+type MailboxQuerier struct {
+	client  cosmwasm.QueryClient
+	address string
+}
+
+func NewMailboxQuerier(address string, client cosmwasm.QueryClient) *MailboxQuerier {
+	return &MailboxQuerier{client, address}
+}
+
+type MailboxQueryRequest struct {
+	Mailbox MailboxQuery `json:"mailbox"`
+}
+
+type MailboxQuery struct {
+	MessageDelivered *MessageDeliveredQuery `json:"message_delivered,omitempty"`
+	RecipientISM     *RecipientISMQuery     `json:"recipient_ism,omitempty"`
+}
+
+type MessageDeliveredQuery struct {
+	ID string `json:"id"`
+}
+
+type RecipientISMQuery struct {
+	RecipientAddr string `json:"recipient_addr"`
+}
+
+func (m *MailboxQuerier) MessageDelivered(ctx context.Context, messageID string) (bool, error) {
+	req := MailboxQueryRequest{
+		MailboxQuery{MessageDelivered: &MessageDeliveredQuery{ID: messageID}},
+	}
+
+	type MessageDeliveredResponse struct {
+		Delivered bool `json:"delivered"`
+	}
+	var resp MessageDeliveredResponse
+	if err := m.query(ctx, req, &resp); err != nil {
+		return false, fmt.Errorf("querying if message %s has been delivered: %w", messageID, err)
+	}
+
+	return resp.Delivered, nil
+}
+
+func (m *MailboxQuerier) RecipientISM(ctx context.Context, recipient string) (string, error) {
+	req := MailboxQueryRequest{
+		MailboxQuery{RecipientISM: &RecipientISMQuery{RecipientAddr: recipient}},
+	}
+
+	type RecipientISMResponse struct {
+		ISM string `json:"ism"`
+	}
+	var resp RecipientISMResponse
+	if err := m.query(ctx, req, &resp); err != nil {
+		return "", fmt.Errorf("querying ism for recipient %s: %w", recipient, err)
+	}
+
+	return resp.ISM, nil
+}
+
+func (m *MailboxQuerier) query(ctx context.Context, req MailboxQueryRequest, out any) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshaling mailbox query request: %w", err)
+	}
+
+	resp, err := m.client.SmartContractState(ctx, &cosmwasm.QuerySmartContractStateRequest{
+		Address:   m.address,
+		QueryData: data,
+	})
+	if err != nil {
+		return fmt.Errorf("querying mailbox smart contract %s: %w", m.address, err)
+	}
+	if resp.Data == nil {
+		return fmt.Errorf("got nil response when querying mailbox")
+	}
+
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		return fmt.Errorf("unmarshaling query bytes into formatted data: %w", err)
+	}
+	return nil
+}
@@ -2,10 +2,15 @@ package cosmos
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	cosmwasm "github.com/CosmWasm/wasmd/x/wasm/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/skip-mev/go-fast-solver/hyperlane/types"
+	"google.golang.org/grpc/metadata"
 )
 
 type MerkleTreeHookQuerier struct {
@@ -25,6 +30,14 @@ type MerkleHookCount struct {
 	Count struct{} `json:"count"`
 }
 
+type MerkleHookTreeRequest struct {
+	MerkleHook MerkleHookTree `json:"merkle_hook"`
+}
+
+type MerkleHookTree struct {
+	Tree struct{} `json:"tree"`
+}
+
 func (mth *MerkleTreeHookQuerier) Count(ctx context.Context) (uint64, error) {
 	req := MerkleHookCountRequest{
 		MerkleHookCount{Count: struct{}{}},
@@ -55,3 +68,53 @@ func (mth *MerkleTreeHookQuerier) Count(ctx context.Context) (uint64, error) {
 
 	return countResponse.Count, nil
 }
+
+// TreeAtHeight queries the merkle hook for the state of its merkle tree at
+// the end of block height
+func (mth *MerkleTreeHookQuerier) TreeAtHeight(ctx context.Context, height int64) (*types.MerkleTree, error) {
+	req := MerkleHookTreeRequest{
+		MerkleHookTree{Tree: struct{}{}},
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling get merkle hook tree request: %w", err)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	resp, err := mth.client.SmartContractState(ctx, &cosmwasm.QuerySmartContractStateRequest{
+		Address:   mth.address,
+		QueryData: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querying smart contract %s for merkle hook tree at height %d: %w", mth.address, height, err)
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("got nil response when querying for merkle hook tree")
+	}
+
+	type TreeResponse struct {
+		Branch []string `json:"branch"`
+		Count  uint64   `json:"count"`
+	}
+	var treeResponse TreeResponse
+	if err := json.Unmarshal(resp.Data, &treeResponse); err != nil {
+		return nil, fmt.Errorf("unmarshaling query bytes into formatted data: %w", err)
+	}
+	if len(treeResponse.Branch) != types.MERKLE_TREE_DEPTH {
+		return nil, fmt.Errorf("expected merkle tree branch of length %d but got %d", types.MERKLE_TREE_DEPTH, len(treeResponse.Branch))
+	}
+
+	tree := &types.MerkleTree{Count: treeResponse.Count}
+	for i, node := range treeResponse.Branch {
+		nodeBytes, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, fmt.Errorf("decoding merkle tree branch node %s: %w", node, err)
+		}
+		if len(nodeBytes) != 32 {
+			return nil, fmt.Errorf("expected merkle tree branch node of length 32 but got %d", len(nodeBytes))
+		}
+		copy(tree.Branch[i][:], nodeBytes)
+	}
+
+	return tree, nil
+}
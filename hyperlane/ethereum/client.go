@@ -12,9 +12,12 @@ import (
 	evmtxexecutor "github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	aggregation_ism "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/AggregationIsm"
 	interchain_security_module "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/InterchainSecurityModule"
 	mailbox "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/Mailbox"
+	merkle_tree_hook "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/MerkleTreeHook"
 	multisig_ism "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/MultisigIsm"
+	validator_announce "github.com/skip-mev/go-fast-solver/shared/contracts/hyperlane/ValidatorAnnounce"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -37,6 +40,9 @@ type HyperlaneClient struct {
 	mailboxAddress  common.Address
 	keystore        keys.KeyStore
 
+	validatorAnnounceAddress common.Address
+	merkleHookAddress        common.Address
+
 	ismAddress     *common.Address
 	ismAddressLock sync.RWMutex
 
@@ -75,11 +81,137 @@ func NewHyperlaneClient(
 		keystore:        keystore,
 		txPriceOracle:   priceOracle,
 		txExecutor:      txSubmitter,
+
+		validatorAnnounceAddress: common.HexToAddress(chainConfig.Relayer.ValidatorAnnounceContractAddress),
+		merkleHookAddress:        common.HexToAddress(chainConfig.Relayer.MerkleHookContractAddress),
 	}, nil
 }
 
 func (c *HyperlaneClient) GetHyperlaneDispatch(ctx context.Context, domain, originChainID, initiateTxHash string) (*types.MailboxDispatchEvent, *types.MailboxMerkleHookPostDispatchEvent, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return nil, nil, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	receipt, err := c.client.GetTxReceipt(ctx, initiateTxHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching tx receipt, hash: %s: %w", initiateTxHash, err)
+	}
+
+	dispatch, err := c.parseDispatch(receipt.Logs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing dispatch event from tx %s logs: %w", initiateTxHash, err)
+	}
+
+	merkleHookPostDispatch, err := c.parseInsertedIntoTree(receipt.Logs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing merkle hook inserted into tree event from tx %s logs: %w", initiateTxHash, err)
+	}
+
+	if merkleHookPostDispatch.MessageID != dispatch.MessageID {
+		return nil, nil, fmt.Errorf("dispatched message id %s does not match message id %s inserted into merkle tree", dispatch.MessageID, merkleHookPostDispatch.MessageID)
+	}
+
+	return dispatch, merkleHookPostDispatch, nil
+}
+
+// parseDispatch parses the mailbox Dispatch and DispatchId events out of a
+// set of tx logs. The recipient, sender and message id are returned as 32
+// byte hex strings with no 0x prefix to match the cosmos dispatch events.
+func (c *HyperlaneClient) parseDispatch(logs []*ethtypes.Log) (*types.MailboxDispatchEvent, error) {
+	mailboxABI, err := mailbox.MailboxMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("getting mailbox abi: %w", err)
+	}
+	mailboxFilterer, err := mailbox.NewMailboxFilterer(c.mailboxAddress, c.client.Client())
+	if err != nil {
+		return nil, fmt.Errorf("creating mailbox filterer for address %s: %w", c.mailboxAddress.String(), err)
+	}
+
+	var d types.MailboxDispatchEvent
+	dispatchFound := false
+	dispatchMessageIDFound := false
+	for _, log := range logs {
+		if log.Address != c.mailboxAddress || len(log.Topics) == 0 {
+			continue
+		}
+
+		switch log.Topics[0] {
+		case mailboxABI.Events["Dispatch"].ID:
+			if dispatchFound {
+				return nil, fmt.Errorf("found multiple dispatch events in tx logs")
+			}
+			dispatchFound = true
+
+			dispatch, err := mailboxFilterer.ParseDispatch(*log)
+			if err != nil {
+				return nil, fmt.Errorf("parsing dispatch event: %w", err)
+			}
+			d.Recipient = hex.EncodeToString(dispatch.Recipient[:])
+			d.Sender = hex.EncodeToString(common.LeftPadBytes(dispatch.Sender.Bytes(), 32))
+			d.DestinationDomain = fmt.Sprintf("%d", dispatch.Destination)
+			d.SenderMailbox = c.mailboxAddress.String()
+			d.Message = hex.EncodeToString(dispatch.Message)
+		case mailboxABI.Events["DispatchId"].ID:
+			if dispatchMessageIDFound {
+				return nil, fmt.Errorf("found multiple dispatch message id events in tx logs")
+			}
+			dispatchMessageIDFound = true
+
+			dispatchID, err := mailboxFilterer.ParseDispatchId(*log)
+			if err != nil {
+				return nil, fmt.Errorf("parsing dispatch id event: %w", err)
+			}
+			d.MessageID = hex.EncodeToString(dispatchID.MessageId[:])
+		}
+	}
+	if !dispatchFound {
+		return nil, fmt.Errorf("could not find dispatch event emitted by mailbox %s", c.mailboxAddress.String())
+	}
+	if !dispatchMessageIDFound {
+		return nil, fmt.Errorf("could not find dispatch id event emitted by mailbox %s", c.mailboxAddress.String())
+	}
+
+	return &d, nil
+}
+
+// parseInsertedIntoTree parses the merkle tree hook InsertedIntoTree event out
+// of a set of tx logs
+func (c *HyperlaneClient) parseInsertedIntoTree(logs []*ethtypes.Log) (*types.MailboxMerkleHookPostDispatchEvent, error) {
+	merkleTreeHookABI, err := merkle_tree_hook.MerkleTreeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("getting merkle tree hook abi: %w", err)
+	}
+	merkleTreeHookFilterer, err := merkle_tree_hook.NewMerkleTreeHookFilterer(c.merkleHookAddress, c.client.Client())
+	if err != nil {
+		return nil, fmt.Errorf("creating merkle tree hook filterer for address %s: %w", c.merkleHookAddress.String(), err)
+	}
+
+	var d types.MailboxMerkleHookPostDispatchEvent
+	found := false
+	for _, log := range logs {
+		if log.Address != c.merkleHookAddress || len(log.Topics) == 0 {
+			continue
+		}
+		if log.Topics[0] != merkleTreeHookABI.Events["InsertedIntoTree"].ID {
+			continue
+		}
+		if found {
+			return nil, fmt.Errorf("found multiple inserted into tree events in tx logs")
+		}
+		found = true
+
+		inserted, err := merkleTreeHookFilterer.ParseInsertedIntoTree(*log)
+		if err != nil {
+			return nil, fmt.Errorf("parsing inserted into tree event: %w", err)
+		}
+		d.MessageID = hex.EncodeToString(inserted.MessageId[:])
+		d.Index = uint64(inserted.Index)
+	}
+	if !found {
+		return nil, fmt.Errorf("could not find inserted into tree event emitted by merkle tree hook %s", c.merkleHookAddress.String())
+	}
+
+	return &d, nil
 }
 
 func (c *HyperlaneClient) HasBeenDelivered(ctx context.Context, domain string, messageID string) (bool, error) {
@@ -112,19 +244,27 @@ func (c *HyperlaneClient) HasBeenDelivered(ctx context.Context, domain string, m
 	return delivered, nil
 }
 
-func (c *HyperlaneClient) ISMType(ctx context.Context, domain string, recipient string) (uint8, error) {
+func (c *HyperlaneClient) RecipientISM(ctx context.Context, domain string, recipient string) (string, error) {
 	if domain != c.hyperlaneDomain {
-		return 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+		return "", fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
 	}
 
 	ismAddress, err := c.getISMAddress(ctx, recipient)
 	if err != nil {
-		return 0, fmt.Errorf("getting ism address for recipeint %s on domain %s: %w", recipient, domain, err)
+		return "", fmt.Errorf("getting ism address for recipient %s on domain %s: %w", recipient, domain, err)
 	}
 
-	ism, err := interchain_security_module.NewInterchainSecurityModuleCaller(ismAddress, c.client.Client())
+	return ismAddress.String(), nil
+}
+
+func (c *HyperlaneClient) ISMType(ctx context.Context, domain string, ismAddress string) (uint8, error) {
+	if domain != c.hyperlaneDomain {
+		return 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	ism, err := interchain_security_module.NewInterchainSecurityModuleCaller(common.HexToAddress(ismAddress), c.client.Client())
 	if err != nil {
-		return 0, fmt.Errorf("creating ism contract caller for address %s: %w", ismAddress.String(), err)
+		return 0, fmt.Errorf("creating ism contract caller for address %s: %w", ismAddress, err)
 	}
 	ismSession := interchain_security_module.InterchainSecurityModuleCallerSession{
 		Contract: ism,
@@ -133,47 +273,43 @@ func (c *HyperlaneClient) ISMType(ctx context.Context, domain string, recipient
 
 	ismType, err := ismSession.ModuleType()
 	if err != nil {
-		return 0, fmt.Errorf("getting ism type for ism address %s: %w", ismAddress.String(), err)
+		return 0, fmt.Errorf("getting ism type for ism address %s: %w", ismAddress, err)
 	}
 
 	return ismType, nil
 }
 
-const (
-	ismTypeMessageIDMultisig = 5
-)
-
 func (c *HyperlaneClient) ValidatorsAndThreshold(
 	ctx context.Context,
 	domain string,
-	recipient string,
+	ismAddress string,
 	message string,
 ) ([]common.Address, uint8, error) {
 	if domain != c.hyperlaneDomain {
 		return nil, 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
 	}
 
-	ismAddress, err := c.getISMAddress(ctx, recipient)
-	if err != nil {
-		return nil, 0, fmt.Errorf("getting ism address for recipeint %s on domain %s: %w", recipient, domain, err)
-	}
-
-	ismType, err := c.ISMType(ctx, domain, recipient)
+	ismType, err := c.ISMType(ctx, domain, ismAddress)
 	if err != nil {
-		return nil, 0, fmt.Errorf("getting ism type for recipient %s on domain %s: %w", recipient, domain, err)
+		return nil, 0, fmt.Errorf("getting ism type for ism %s on domain %s: %w", ismAddress, domain, err)
 	}
 
 	switch ismType {
-	case ismTypeMessageIDMultisig:
-		multisigISM, err := multisig_ism.NewMultisigIsmCaller(ismAddress, c.client.Client())
+	case types.ISMTypeMessageIDMultisig, types.ISMTypeMerkleRootMultisig:
+		messageBytes, err := hex.DecodeString(strings.TrimPrefix(message, "0x"))
+		if err != nil {
+			return nil, 0, fmt.Errorf("hex decoding message %s: %w", message, err)
+		}
+
+		multisigISM, err := multisig_ism.NewMultisigIsmCaller(common.HexToAddress(ismAddress), c.client.Client())
 		if err != nil {
-			return nil, 0, fmt.Errorf("creating multisign ism contract caller for address %s: %w", ismAddress.String(), err)
+			return nil, 0, fmt.Errorf("creating multisign ism contract caller for address %s: %w", ismAddress, err)
 		}
 		multisigISMSession := multisig_ism.MultisigIsmCallerSession{Contract: multisigISM, CallOpts: bind.CallOpts{Context: ctx}}
 
-		validatorsAndThreshold, err := multisigISMSession.ValidatorsAndThreshold([]byte(message))
+		validatorsAndThreshold, err := multisigISMSession.ValidatorsAndThreshold(messageBytes)
 		if err != nil {
-			return nil, 0, fmt.Errorf("fetching validators and threshold from multisig ism at address %s: %w", ismAddress.String(), err)
+			return nil, 0, fmt.Errorf("fetching validators and threshold from multisig ism at address %s: %w", ismAddress, err)
 		}
 
 		return validatorsAndThreshold.Validators, validatorsAndThreshold.Threshold, nil
@@ -182,6 +318,40 @@ func (c *HyperlaneClient) ValidatorsAndThreshold(
 	}
 }
 
+func (c *HyperlaneClient) ModulesAndThreshold(
+	ctx context.Context,
+	domain string,
+	ismAddress string,
+	message string,
+) ([]string, uint8, error) {
+	if domain != c.hyperlaneDomain {
+		return nil, 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	messageBytes, err := hex.DecodeString(strings.TrimPrefix(message, "0x"))
+	if err != nil {
+		return nil, 0, fmt.Errorf("hex decoding message %s: %w", message, err)
+	}
+
+	aggregationISM, err := aggregation_ism.NewAggregationIsmCaller(common.HexToAddress(ismAddress), c.client.Client())
+	if err != nil {
+		return nil, 0, fmt.Errorf("creating aggregation ism contract caller for address %s: %w", ismAddress, err)
+	}
+	aggregationISMSession := aggregation_ism.AggregationIsmCallerSession{Contract: aggregationISM, CallOpts: bind.CallOpts{Context: ctx}}
+
+	modulesAndThreshold, err := aggregationISMSession.ModulesAndThreshold(messageBytes)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching modules and threshold from aggregation ism at address %s: %w", ismAddress, err)
+	}
+
+	var modules []string
+	for _, module := range modulesAndThreshold.Modules {
+		modules = append(modules, module.String())
+	}
+
+	return modules, modulesAndThreshold.Threshold, nil
+}
+
 func (c *HyperlaneClient) getISMAddress(ctx context.Context, recipient string) (common.Address, error) {
 	c.ismAddressLock.RLock()
 	if c.ismAddress != nil {
@@ -316,7 +486,79 @@ func (c *HyperlaneClient) QuoteProcessUUSDC(ctx context.Context, domain string,
 }
 
 func (c *HyperlaneClient) MerkleTreeLeafCount(ctx context.Context, domain string) (uint64, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return 0, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	merkleTreeHook, err := merkle_tree_hook.NewMerkleTreeHookCaller(c.merkleHookAddress, c.client.Client())
+	if err != nil {
+		return 0, fmt.Errorf("creating merkle tree hook contract caller for address %s: %w", c.merkleHookAddress.String(), err)
+	}
+	merkleTreeHookSession := merkle_tree_hook.MerkleTreeHookCallerSession{
+		Contract: merkleTreeHook,
+		CallOpts: bind.CallOpts{Context: ctx},
+	}
+
+	count, err := merkleTreeHookSession.Count()
+	if err != nil {
+		return 0, fmt.Errorf("querying merkle tree hook at %s for leaf count: %w", c.merkleHookAddress.String(), err)
+	}
+
+	return uint64(count), nil
+}
+
+// MerkleTreeAtIndex reconstructs the state of the origin merkle tree hook
+// directly after the leaf at index was inserted by the dispatch in
+// initiateTxHash. The tree state at the end of the previous block is queried
+// and then all leaves inserted in the dispatch block up to index are
+// inserted.
+func (c *HyperlaneClient) MerkleTreeAtIndex(ctx context.Context, domain, originChainID, initiateTxHash string, index uint64) (*types.MerkleTree, error) {
+	if domain != c.hyperlaneDomain {
+		return nil, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	receipt, err := c.client.GetTxReceipt(ctx, initiateTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("fetching tx receipt, hash: %s: %w", initiateTxHash, err)
+	}
+	dispatchHeight := receipt.BlockNumber.Uint64()
+
+	merkleTreeHook, err := merkle_tree_hook.NewMerkleTreeHook(c.merkleHookAddress, c.client.Client())
+	if err != nil {
+		return nil, fmt.Errorf("creating merkle tree hook contract for address %s: %w", c.merkleHookAddress.String(), err)
+	}
+
+	previousTree, err := merkleTreeHook.Tree(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(dispatchHeight - 1)})
+	if err != nil {
+		return nil, fmt.Errorf("querying merkle tree hook at %s for tree at height %d: %w", c.merkleHookAddress.String(), dispatchHeight-1, err)
+	}
+	tree := &types.MerkleTree{Branch: previousTree.Branch, Count: previousTree.Count.Uint64()}
+	if tree.Count > index {
+		return nil, fmt.Errorf("merkle tree at height %d already has %d leaves, expected leaf %d to be inserted at height %d", dispatchHeight-1, tree.Count, index, dispatchHeight)
+	}
+
+	insertions, err := merkleTreeHook.FilterInsertedIntoTree(&bind.FilterOpts{Context: ctx, Start: dispatchHeight, End: &dispatchHeight})
+	if err != nil {
+		return nil, fmt.Errorf("filtering inserted into tree events at height %d: %w", dispatchHeight, err)
+	}
+	defer insertions.Close()
+
+	for tree.Count <= index && insertions.Next() {
+		if uint64(insertions.Event.Index) != tree.Count {
+			return nil, fmt.Errorf("expected next leaf inserted into tree to have index %d but got %d", tree.Count, insertions.Event.Index)
+		}
+		if err := tree.Insert(insertions.Event.MessageId); err != nil {
+			return nil, fmt.Errorf("inserting leaf %d into merkle tree: %w", insertions.Event.Index, err)
+		}
+	}
+	if err := insertions.Error(); err != nil {
+		return nil, fmt.Errorf("iterating inserted into tree events at height %d: %w", dispatchHeight, err)
+	}
+	if tree.Count != index+1 {
+		return nil, fmt.Errorf("could not find leaf %d inserted into merkle tree at height %d", index, dispatchHeight)
+	}
+
+	return tree, nil
 }
 
 func (c *HyperlaneClient) ValidatorStorageLocations(
@@ -324,7 +566,42 @@ func (c *HyperlaneClient) ValidatorStorageLocations(
 	domain string,
 	validators []common.Address,
 ) ([]*types.ValidatorStorageLocation, error) {
-	panic("not implemented")
+	if domain != c.hyperlaneDomain {
+		return nil, fmt.Errorf("expected domain %s but got %s", c.hyperlaneDomain, domain)
+	}
+
+	validatorAnnounce, err := validator_announce.NewValidatorAnnounceCaller(c.validatorAnnounceAddress, c.client.Client())
+	if err != nil {
+		return nil, fmt.Errorf("creating validator announce contract caller for address %s: %w", c.validatorAnnounceAddress.String(), err)
+	}
+	validatorAnnounceSession := validator_announce.ValidatorAnnounceCallerSession{
+		Contract: validatorAnnounce,
+		CallOpts: bind.CallOpts{Context: ctx},
+	}
+
+	storageLocations, err := validatorAnnounceSession.GetAnnouncedStorageLocations(validators)
+	if err != nil {
+		return nil, fmt.Errorf("getting storage locations for validators %+v: %w", validators, err)
+	}
+	if len(storageLocations) != len(validators) {
+		return nil, fmt.Errorf("expected storage locations for %d validators but got %d", len(validators), len(storageLocations))
+	}
+
+	var validatorStorageLocations []*types.ValidatorStorageLocation
+	for i, locations := range storageLocations {
+		// a validator may announce multiple storage locations, we will simply
+		// take the last announced location as the one the validator is
+		// intending to use
+		if len(locations) == 0 {
+			return nil, fmt.Errorf("expected at least one storage location for validator %s, got none", validators[i].String())
+		}
+		validatorStorageLocations = append(validatorStorageLocations, &types.ValidatorStorageLocation{
+			Validator:       validators[i].String(),
+			StorageLocation: locations[len(locations)-1],
+		})
+	}
+
+	return validatorStorageLocations, nil
 }
 
 func (c *HyperlaneClient) IsContract(ctx context.Context, domain, address string) (bool, error) {
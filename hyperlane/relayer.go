@@ -5,13 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
+	"time"
 
 	dbtypes "github.com/skip-mev/go-fast-solver/db"
 	"github.com/skip-mev/go-fast-solver/shared/metrics"
 
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/skip-mev/go-fast-solver/hyperlane/types"
 
 	"github.com/skip-mev/go-fast-solver/shared/config"
@@ -24,18 +29,43 @@ type Relayer interface {
 	Relay(ctx context.Context, originChainID string, initiateTxHash string, maxTxFeeUUSDC *big.Int) (destinationTxHash string, destinationChainID string, rawTx string, err error)
 }
 
+const (
+	// checkpointFetchTimeout is how long to wait for a single validator to
+	// return a checkpoint before ignoring it for the current relay attempt
+	checkpointFetchTimeout = 5 * time.Second
+	// checkpointCacheSize is the max number of verified checkpoints (one per
+	// validator per index) to keep in memory across relay attempts
+	checkpointCacheSize = 10000
+)
+
 type relayer struct {
 	hyperlane                Client
 	storageLocationOverrides map[string]string
+	checkpointCache          *lru.Cache
 }
 
 func NewRelayer(hyperlaneClient Client, storageLocationOverrides map[string]string) Relayer {
+	// validator addresses are keyed without a 0x prefix and lower cased so
+	// that overrides match regardless of how the origin chain formats them
+	normalizedOverrides := make(map[string]string, len(storageLocationOverrides))
+	for validator, storageLocation := range storageLocationOverrides {
+		normalizedOverrides[normalizeValidatorAddress(validator)] = storageLocation
+	}
+
+	// lru.New only errors on a non positive size
+	checkpointCache, _ := lru.New(checkpointCacheSize)
+
 	return &relayer{
 		hyperlane:                hyperlaneClient,
-		storageLocationOverrides: storageLocationOverrides,
+		storageLocationOverrides: normalizedOverrides,
+		checkpointCache:          checkpointCache,
 	}
 }
 
+func normalizeValidatorAddress(validator string) string {
+	return strings.ToLower(strings.TrimPrefix(validator, "0x"))
+}
+
 var (
 	ErrRelayTooExpensive        = fmt.Errorf("relay is too expensive")
 	ErrMessageAlreadyDelivered  = fmt.Errorf("message has already been delivered")
@@ -76,18 +106,200 @@ func (r *relayer) Relay(ctx context.Context, originChainID string, initiateTxHas
 		return "", "", "", fmt.Errorf("recipient %s is not a contract", dispatch.Recipient)
 	}
 
+	// build the metadata that the recipients ism needs in order to verify
+	// the message on the destination chain
+	ismAddress, err := r.hyperlane.RecipientISM(ctx, dispatch.DestinationDomain, dispatch.Recipient)
+	if err != nil {
+		return "", "", "", fmt.Errorf("getting ism for recipient %s on domain %s: %w", dispatch.Recipient, dispatch.DestinationDomain, err)
+	}
+
+	metadata, err := r.ismMetadata(ctx, originChainID, initiateTxHash, dispatch, merkleHookPostDispatch, ismAddress)
+	if err != nil {
+		return "", "", "", fmt.Errorf("creating message metadata for ism %s on domain %s: %w", ismAddress, dispatch.DestinationDomain, err)
+	}
+
+	// submit the message to the destination mailbox for processing (ism
+	// verification, emit events, calling recipient contract)
+	message, err := hex.DecodeString(dispatch.Message)
+	if err != nil {
+		return "", "", "", fmt.Errorf("hex decoding dispatch message to bytes: %w", err)
+	}
+
+	// if the user specified a max tx fee, ensure that the tx fee to relay will
+	// be less than this amount
+	if maxTxFeeUUSDC != nil {
+		isFeeLessThanMax, err := r.isRelayFeeLessThanMax(ctx, dispatch.DestinationDomain, message, metadata, maxTxFeeUUSDC)
+		if err != nil {
+			return "", "", "", fmt.Errorf("checking if relay to domain %s is profitable: %w", dispatch.DestinationDomain, err)
+		}
+		if !isFeeLessThanMax {
+			metrics.FromContext(ctx).IncHyperlaneRelayTooExpensive(originChainID, destinationChainID)
+			return "", "", "", ErrRelayTooExpensive
+		}
+	}
+
+	hash, rawTx, err := r.hyperlane.Process(ctx, dispatch.DestinationDomain, message, metadata)
+	metrics.FromContext(ctx).IncTransactionSubmitted(err == nil, destinationChainID, dbtypes.TxTypeHyperlaneMessageDelivery)
+	if err != nil {
+		return "", "", "", fmt.Errorf("processing message on domain %s: %w", dispatch.DestinationDomain, err)
+	}
+
+	lmt.Logger(ctx).Info(
+		fmt.Sprintf("relayed hyperlane message from %s to %s", originChainConfig.ChainName, destinationChainConfig.ChainName),
+		zap.String("originDispatchTxHash", initiateTxHash),
+		zap.String("destinationProcessTxHash", hex.EncodeToString(hash)),
+	)
+
+	return hex.EncodeToString(hash), destinationChainID, rawTx, nil
+}
+
+// ismMetadata creates the metadata that the ism at ismAddress on the
+// destination chain needs in order to verify the dispatched message. For
+// aggregation isms this recurses into the isms sub modules.
+func (r *relayer) ismMetadata(
+	ctx context.Context,
+	originChainID string,
+	initiateTxHash string,
+	dispatch *types.MailboxDispatchEvent,
+	merkleHookPostDispatch *types.MailboxMerkleHookPostDispatchEvent,
+	ismAddress string,
+) ([]byte, error) {
+	ismType, err := r.hyperlane.ISMType(ctx, dispatch.DestinationDomain, ismAddress)
+	if err != nil {
+		return nil, fmt.Errorf("getting ism type for ism %s on domain %s: %w", ismAddress, dispatch.DestinationDomain, err)
+	}
+
+	switch ismType {
+	case types.ISMTypeMessageIDMultisig:
+		quorumCheckpoint, err := r.multisigCheckpoint(ctx, originChainID, dispatch, merkleHookPostDispatch, ismAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// convert the checkpoint to metadata to be passed to the destination
+		// ism for verification
+		metadata, err := quorumCheckpoint.ToMetadata()
+		if err != nil {
+			return nil, fmt.Errorf("creating message metadata from multisig checkpoint: %w", err)
+		}
+		return metadata, nil
+	case types.ISMTypeMerkleRootMultisig:
+		quorumCheckpoint, err := r.multisigCheckpoint(ctx, originChainID, dispatch, merkleHookPostDispatch, ismAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		originChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(originChainID)
+		if err != nil {
+			return nil, fmt.Errorf("getting chain config for chainID %s: %w", originChainID, err)
+		}
+
+		// prove that the message id is included in the merkle root that the
+		// validators signed
+		tree, err := r.hyperlane.MerkleTreeAtIndex(ctx, originChainConfig.HyperlaneDomain, originChainID, initiateTxHash, merkleHookPostDispatch.Index)
+		if err != nil {
+			return nil, fmt.Errorf("getting origin merkle tree at index %d: %w", merkleHookPostDispatch.Index, err)
+		}
+		proof, err := tree.LatestLeafProof()
+		if err != nil {
+			return nil, fmt.Errorf("getting merkle proof for message at index %d: %w", merkleHookPostDispatch.Index, err)
+		}
+		messageID, err := hex.DecodeString(strings.TrimPrefix(dispatch.MessageID, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("hex decoding message id %s: %w", dispatch.MessageID, err)
+		}
+		root := types.BranchRoot(common.BytesToHash(messageID), proof, merkleHookPostDispatch.Index)
+		if hex.EncodeToString(root[:]) != strings.TrimPrefix(quorumCheckpoint.Checkpoint.Checkpoint.Root, "0x") {
+			return nil, fmt.Errorf("mismatch merkle root in checkpoint and merkle proof. merkle proof has %s and checkpoint has %s", hex.EncodeToString(root[:]), quorumCheckpoint.Checkpoint.Checkpoint.Root)
+		}
+
+		metadata, err := quorumCheckpoint.ToMerkleRootMetadata(uint32(merkleHookPostDispatch.Index), proof)
+		if err != nil {
+			return nil, fmt.Errorf("creating message metadata from merkle root multisig checkpoint: %w", err)
+		}
+		return metadata, nil
+	case types.ISMTypeAggregation:
+		modules, threshold, err := r.hyperlane.ModulesAndThreshold(ctx, dispatch.DestinationDomain, ismAddress, dispatch.Message)
+		if err != nil {
+			return nil, fmt.Errorf("getting modules and threshold from aggregation ism %s on domain %s: %w", ismAddress, dispatch.DestinationDomain, err)
+		}
+
+		lmt.Logger(ctx).Debug(
+			"got modules and threshold from aggregation ism",
+			zap.String("ism", ismAddress),
+			zap.Strings("modules", modules),
+			zap.Uint8("threshold", threshold),
+		)
+
+		// only metadata for threshold sub modules is needed, any sub modules
+		// that we cannot create metadata for are left empty
+		subModuleMetadata := make([][]byte, len(modules))
+		var metadataFound uint8
+		var lastErr error
+		for i, module := range modules {
+			if metadataFound >= threshold {
+				break
+			}
+
+			metadata, err := r.ismMetadata(ctx, originChainID, initiateTxHash, dispatch, merkleHookPostDispatch, module)
+			if err != nil {
+				lmt.Logger(ctx).Debug(
+					"could not create metadata for aggregation ism sub module",
+					zap.String("ism", ismAddress),
+					zap.String("module", module),
+					zap.Error(err),
+				)
+				lastErr = err
+				continue
+			}
+			subModuleMetadata[i] = metadata
+			metadataFound++
+		}
+		if metadataFound < threshold {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("aggregation ism has %d sub modules", len(modules))
+			}
+			return nil, fmt.Errorf("created metadata for %d of %d required sub modules of aggregation ism %s: %w", metadataFound, threshold, ismAddress, lastErr)
+		}
+
+		metadata, err := types.AggregationMetadata(subModuleMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("creating aggregation metadata: %w", err)
+		}
+		return metadata, nil
+	default:
+		return nil, fmt.Errorf("ism type %d not supported", ismType)
+	}
+}
+
+// multisigCheckpoint fetches a checkpoint for the dispatched message that has
+// been signed by a quorum of the validators of the multisig ism at
+// ismAddress
+func (r *relayer) multisigCheckpoint(
+	ctx context.Context,
+	originChainID string,
+	dispatch *types.MailboxDispatchEvent,
+	merkleHookPostDispatch *types.MailboxMerkleHookPostDispatchEvent,
+	ismAddress string,
+) (types.MultiSigSignedCheckpoint, error) {
+	originChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(originChainID)
+	if err != nil {
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("getting chain config for chainID %s: %w", originChainID, err)
+	}
+
 	// fetch all validators that should validate this message according to the
 	// destination chains ism, and get how many of them need to validate
-	validators, threshold, err := r.hyperlane.ValidatorsAndThreshold(ctx, dispatch.DestinationDomain, dispatch.Recipient, dispatch.Message)
+	validators, threshold, err := r.hyperlane.ValidatorsAndThreshold(ctx, dispatch.DestinationDomain, ismAddress, dispatch.Message)
 	if err != nil {
-		return "", "", "", fmt.Errorf("getting validators and quorum threshold from doamin %s for recipient %s: %w", dispatch.DestinationDomain, dispatch.Recipient, err)
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("getting validators and quorum threshold from doamin %s for ism %s: %w", dispatch.DestinationDomain, ismAddress, err)
 	}
 	if len(validators) == 0 {
-		return "", "", "", fmt.Errorf("no validator set received from multisig ism")
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("no validator set received from multisig ism")
 	}
 
 	lmt.Logger(ctx).Debug(
-		"got validators and threshold from recipient ism",
+		"got validators and threshold from multisig ism",
+		zap.String("ism", ismAddress),
 		zap.Any("validators", validators),
 		zap.Uint8("threshold", threshold),
 	)
@@ -96,7 +308,7 @@ func (r *relayer) Relay(ctx context.Context, originChainID string, initiateTxHas
 	// chains validator announce contract
 	validatorStorageLocations, err := r.hyperlane.ValidatorStorageLocations(ctx, originChainConfig.HyperlaneDomain, validators)
 	if err != nil {
-		return "", "", "", fmt.Errorf("getting validator storage locations on domain %s for validators %v: %w", originChainConfig.HyperlaneDomain, validators, err)
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("getting validator storage locations on domain %s for validators %v: %w", originChainConfig.HyperlaneDomain, validators, err)
 	}
 
 	lmt.Logger(ctx).Debug(
@@ -110,130 +322,111 @@ func (r *relayer) Relay(ctx context.Context, originChainID string, initiateTxHas
 	for _, validatorStorageLocation := range validatorStorageLocations {
 		validator := validatorStorageLocation.Validator
 		storageLocation := validatorStorageLocation.StorageLocation
-		if override, ok := r.storageLocationOverrides[validator]; ok {
+		if override, ok := r.storageLocationOverrides[normalizeValidatorAddress(validator)]; ok {
 			storageLocation = override
 		}
 
 		fetcher, err := NewCheckpointFetcherFromStorageLocation(storageLocation, validator)
 		if err != nil {
-			return "", "", "", fmt.Errorf("creating checkpoint fetcher from storage location %s for validator %s: %w", storageLocation, validator, err)
+			return types.MultiSigSignedCheckpoint{}, fmt.Errorf("creating checkpoint fetcher from storage location %s for validator %s: %w", storageLocation, validator, err)
 		}
 		checkpointFetchers = append(checkpointFetchers, fetcher)
 	}
 
 	// fetch the checkpoint at index if we have reached a quorum of validators
 	// there
-	quorumCheckpoint, err := r.checkpointAtIndex(ctx, merkleHookPostDispatch.Index, checkpointFetchers, threshold, dispatch.MessageID)
-	if err != nil {
-		return "", "", "", fmt.Errorf("getting checkpoint at index %d: %w", merkleHookPostDispatch.Index, err)
-	}
-
-	lmt.Logger(ctx).Debug("found checkpoint with quorum", zap.Uint64("index", merkleHookPostDispatch.Index))
-
-	// convert the checkpoint to metadata to be passed to the destination ism
-	// for verification
-	metadata, err := quorumCheckpoint.ToMetadata()
+	originDomain, err := strconv.ParseUint(originChainConfig.HyperlaneDomain, 10, 32)
 	if err != nil {
-		return "", "", "", fmt.Errorf("creating message metadata from multisig checkpoint: %w", err)
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("parsing hyperlane domain %s of chainID %s: %w", originChainConfig.HyperlaneDomain, originChainID, err)
 	}
 
-	// submit the message to the destination mailbox for processing (ism
-	// verification, emit events, calling recipient contract)
-	message, err := hex.DecodeString(dispatch.Message)
+	quorumCheckpoint, err := r.checkpointAtIndex(ctx, uint32(originDomain), merkleHookPostDispatch.Index, checkpointFetchers, threshold, dispatch.MessageID)
 	if err != nil {
-		return "", "", "", fmt.Errorf("hex decoding dispatch message to bytes: %w", err)
+		return types.MultiSigSignedCheckpoint{}, fmt.Errorf("getting checkpoint at index %d: %w", merkleHookPostDispatch.Index, err)
 	}
 
-	// if the user specified a max tx fee, ensure that the tx fee to relay will
-	// be less than this amount
-	if maxTxFeeUUSDC != nil {
-		isFeeLessThanMax, err := r.isRelayFeeLessThanMax(ctx, dispatch.DestinationDomain, message, metadata, maxTxFeeUUSDC)
-		if err != nil {
-			return "", "", "", fmt.Errorf("checking if relay to domain %s is profitable: %w", dispatch.DestinationDomain, err)
-		}
-		if !isFeeLessThanMax {
-			metrics.FromContext(ctx).IncHyperlaneRelayTooExpensive(originChainID, destinationChainID)
-			return "", "", "", ErrRelayTooExpensive
-		}
-	}
+	lmt.Logger(ctx).Debug("found checkpoint with quorum", zap.Uint64("index", merkleHookPostDispatch.Index))
 
-	hash, rawTx, err := r.hyperlane.Process(ctx, dispatch.DestinationDomain, message, metadata)
-	metrics.FromContext(ctx).IncTransactionSubmitted(err == nil, destinationChainID, dbtypes.TxTypeHyperlaneMessageDelivery)
-	if err != nil {
-		return "", "", "", fmt.Errorf("processing message on domain %s: %w", dispatch.DestinationDomain, err)
-	}
+	return quorumCheckpoint, nil
+}
 
-	lmt.Logger(ctx).Info(
-		fmt.Sprintf("relayed hyperlane message from %s to %s", originChainConfig.ChainName, destinationChainConfig.ChainName),
-		zap.String("originDispatchTxHash", initiateTxHash),
-		zap.String("destinationProcessTxHash", hex.EncodeToString(hash)),
-	)
+// checkpointCacheKey identifies a validators checkpoint. Validators may sign
+// checkpoints for more than one origin chain at the same index, so the origin
+// domain is part of the key.
+type checkpointCacheKey struct {
+	originDomain uint32
+	validator    string
+	index        uint64
+}
 
-	return hex.EncodeToString(hash), destinationChainID, rawTx, nil
+type fetchedCheckpoint struct {
+	// fetcherIndex is the index of the fetcher that fetched this checkpoint,
+	// which is the same as the index of the validator in the ism validator
+	// set since fetchers are created in validator set order
+	fetcherIndex int
+	checkpoint   *types.SignedCheckpoint
+	err          error
 }
 
+// checkpointAtIndex concurrently fetches the checkpoint at index for the
+// origin domain from each validator and returns as soon as threshold
+// validators have signed the same root
 func (r *relayer) checkpointAtIndex(
 	ctx context.Context,
+	originDomain uint32,
 	index uint64,
 	checkpointFetchers []CheckpointFetcher,
 	threshold uint8,
 	messageID string,
 ) (types.MultiSigSignedCheckpoint, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that fetches still in flight when we return early do not
+	// block forever
+	results := make(chan fetchedCheckpoint, len(checkpointFetchers))
+	for i, fetcher := range checkpointFetchers {
+		go func(i int, fetcher CheckpointFetcher) {
+			checkpoint, err := r.verifiedCheckpoint(ctx, fetcher, originDomain, index)
+			results <- fetchedCheckpoint{fetcherIndex: i, checkpoint: checkpoint, err: err}
+		}(i, fetcher)
+	}
+
 	var multiSigCheckpoint types.MultiSigSignedCheckpoint
-	signedCheckpointsPerRoot := make(map[string][]types.SignedCheckpoint)
-	for _, fetcher := range checkpointFetchers {
-		signedCheckpoint, err := fetcher.Checkpoint(ctx, index)
-		if errors.Is(err, ErrCheckpointDoesNotExist) {
+	signedCheckpointsPerRoot := make(map[string][]fetchedCheckpoint)
+	for range checkpointFetchers {
+		result := <-results
+		if errors.Is(result.err, ErrCheckpointDoesNotExist) {
 			// if the validator for this fetcher has not signed the
 			// checkpoint, ignore it
 			continue
 		}
-		if err != nil {
+		if result.err != nil {
 			metrics.FromContext(ctx).IncHyperlaneCheckpointingErrors()
-			return types.MultiSigSignedCheckpoint{}, fmt.Errorf("fetching checkpoint at index %d: %w", index, err)
-		}
-
-		// ensure that the checkpoint is actually for this index
-		if uint64(signedCheckpoint.Value.Checkpoint.Index) != index {
-			lmt.Logger(ctx).Debug(
-				"checkpoint index mismatch",
-				zap.Uint64("expected", index),
-				zap.Uint32("got", signedCheckpoint.Value.Checkpoint.Index),
-			)
-			continue
-		}
-
-		digest, err := signedCheckpoint.Digest()
-		if err != nil {
-			return types.MultiSigSignedCheckpoint{}, fmt.Errorf("hex decoding checkpoint root: %w", err)
-		}
-		pubkey, err := signedCheckpoint.Signature.RecoverPubKey(digest)
-		if err != nil {
-			return types.MultiSigSignedCheckpoint{}, fmt.Errorf("recovering pubkey from signature: %w", err)
-		}
-		signature, err := signedCheckpoint.Signature.RSBytes()
-		if err != nil {
-			return types.MultiSigSignedCheckpoint{}, fmt.Errorf("converting checkpoint signature to bytes: %w", err)
-		}
-		if !crypto.VerifySignature(pubkey, digest, signature) {
 			lmt.Logger(ctx).Warn(
-				"checkpoint signature is not from validator",
-				zap.String("validator", fetcher.Validator()),
+				"error fetching checkpoint from validator",
+				zap.String("validator", checkpointFetchers[result.fetcherIndex].Validator()),
 				zap.Uint64("checkpointIndex", index),
+				zap.Error(result.err),
 			)
 			continue
 		}
-
-		root := signedCheckpoint.Value.Checkpoint.Root
-		if _, ok := signedCheckpointsPerRoot[root]; !ok {
-			signedCheckpointsPerRoot[root] = make([]types.SignedCheckpoint, 0)
+		if result.checkpoint == nil {
+			continue
 		}
-		signedCheckpointsPerRoot[root] = append(signedCheckpointsPerRoot[root], *signedCheckpoint)
+
+		root := result.checkpoint.Value.Checkpoint.Root
+		signedCheckpointsPerRoot[root] = append(signedCheckpointsPerRoot[root], result)
 
 		if len(signedCheckpointsPerRoot[root]) >= int(threshold) {
-			multiSigCheckpoint.Checkpoint = signedCheckpoint.Value
-			for _, checkpoint := range signedCheckpointsPerRoot[root] {
-				multiSigCheckpoint.Signatures = append(multiSigCheckpoint.Signatures, checkpoint.Signature)
+			// multisig isms expect signatures to be ordered the same as the
+			// validator set
+			signed := signedCheckpointsPerRoot[root]
+			sort.Slice(signed, func(i, j int) bool { return signed[i].fetcherIndex < signed[j].fetcherIndex })
+
+			multiSigCheckpoint.Checkpoint = result.checkpoint.Value
+			for _, checkpoint := range signed {
+				multiSigCheckpoint.Signatures = append(multiSigCheckpoint.Signatures, checkpoint.checkpoint.Signature)
 			}
 			break
 		}
@@ -254,6 +447,83 @@ func (r *relayer) checkpointAtIndex(
 	return multiSigCheckpoint, nil
 }
 
+// verifiedCheckpoint fetches the checkpoint at index from a validator and
+// verifies that it is for the origin domain and index and signed by the
+// validator. Verified checkpoints are cached so that they are not fetched again
+// on subsequent relay attempts. Returns a nil checkpoint if the validator has
+// signed an invalid checkpoint, or the checkpoint was not signed by the
+// validator.
+func (r *relayer) verifiedCheckpoint(ctx context.Context, fetcher CheckpointFetcher, originDomain uint32, index uint64) (*types.SignedCheckpoint, error) {
+	key := checkpointCacheKey{originDomain: originDomain, validator: normalizeValidatorAddress(fetcher.Validator()), index: index}
+	if cached, ok := r.checkpointCache.Get(key); ok {
+		return cached.(*types.SignedCheckpoint), nil
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, checkpointFetchTimeout)
+	defer cancel()
+
+	signedCheckpoint, err := fetcher.Checkpoint(fetchCtx, index)
+	if err != nil {
+		if errors.Is(err, ErrCheckpointDoesNotExist) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("fetching checkpoint at index %d: %w", index, err)
+	}
+
+	// ensure that the checkpoint is actually for this index
+	if uint64(signedCheckpoint.Value.Checkpoint.Index) != index {
+		lmt.Logger(ctx).Debug(
+			"checkpoint index mismatch",
+			zap.Uint64("expected", index),
+			zap.Uint32("got", signedCheckpoint.Value.Checkpoint.Index),
+		)
+		return nil, nil
+	}
+
+	// ensure that the checkpoint is for the origin chains mailbox
+	if signedCheckpoint.Value.Checkpoint.MailboxDomain != originDomain {
+		lmt.Logger(ctx).Debug(
+			"checkpoint mailbox domain mismatch",
+			zap.Uint32("expected", originDomain),
+			zap.Uint32("got", signedCheckpoint.Value.Checkpoint.MailboxDomain),
+		)
+		return nil, nil
+	}
+
+	digest, err := signedCheckpoint.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("hex decoding checkpoint root: %w", err)
+	}
+	pubkey, err := signedCheckpoint.Signature.RecoverPubKey(digest)
+	if err != nil {
+		return nil, fmt.Errorf("recovering pubkey from signature: %w", err)
+	}
+	signature, err := signedCheckpoint.Signature.RSBytes()
+	if err != nil {
+		return nil, fmt.Errorf("converting checkpoint signature to bytes: %w", err)
+	}
+	// the pubkey is recovered from the signature itself, so the signature
+	// always verifies against it. the checkpoint is only signed by the
+	// validator if the recovered pubkey is the validators.
+	ecdsaPubkey, err := crypto.UnmarshalPubkey(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalling recovered pubkey: %w", err)
+	}
+	signer := crypto.PubkeyToAddress(*ecdsaPubkey)
+	if normalizeValidatorAddress(signer.Hex()) != normalizeValidatorAddress(fetcher.Validator()) || !crypto.VerifySignature(pubkey, digest, signature) {
+		lmt.Logger(ctx).Warn(
+			"checkpoint signature is not from validator",
+			zap.String("validator", fetcher.Validator()),
+			zap.String("signer", signer.Hex()),
+			zap.Uint64("checkpointIndex", index),
+		)
+		return nil, nil
+	}
+
+	r.checkpointCache.Add(key, signedCheckpoint)
+	return signedCheckpoint, nil
+}
+
 var (
 	ErrCouldNotDetermineRelayFee = fmt.Errorf("could not determine relay fee")
 )
@@ -0,0 +1,162 @@
+package hyperlane
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/skip-mev/go-fast-solver/hyperlane/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMessageID    = "cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"
+	testOriginDomain = 1
+)
+
+type mockCheckpointFetcher struct {
+	validator  string
+	checkpoint *types.SignedCheckpoint
+	delay      time.Duration
+	calls      atomic.Int64
+}
+
+func (f *mockCheckpointFetcher) LatestIndex(ctx context.Context) (uint64, error) {
+	return uint64(f.checkpoint.Value.Checkpoint.Index), nil
+}
+
+func (f *mockCheckpointFetcher) Checkpoint(ctx context.Context, index uint64) (*types.SignedCheckpoint, error) {
+	f.calls.Add(1)
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if f.checkpoint == nil {
+		return nil, ErrCheckpointDoesNotExist
+	}
+	return f.checkpoint, nil
+}
+
+func (f *mockCheckpointFetcher) Validator() string {
+	return f.validator
+}
+
+func newSignedCheckpoint(t *testing.T, key *ecdsa.PrivateKey, index uint32) *types.SignedCheckpoint {
+	checkpoint := &types.SignedCheckpoint{
+		Value: types.CheckpointWithMessageID{
+			Checkpoint: types.Checkpoint{
+				MerkleTreeHookAddress: "0x" + strings.Repeat("aa", 32),
+				MailboxDomain:         testOriginDomain,
+				Root:                  "0x" + strings.Repeat("bb", 32),
+				Index:                 index,
+			},
+			MessageID: "0x" + testMessageID,
+		},
+	}
+	digest, err := checkpoint.Digest()
+	require.NoError(t, err)
+	signature, err := crypto.Sign(digest, key)
+	require.NoError(t, err)
+
+	checkpoint.Signature = types.Signature{
+		R: hex.EncodeToString(signature[:32]),
+		S: hex.EncodeToString(signature[32:64]),
+		V: signature[64] + 27,
+	}
+	return checkpoint
+}
+
+func newMockCheckpointFetcher(t *testing.T, index uint32, delay time.Duration) *mockCheckpointFetcher {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &mockCheckpointFetcher{
+		validator:  crypto.PubkeyToAddress(key.PublicKey).String(),
+		checkpoint: newSignedCheckpoint(t, key, index),
+		delay:      delay,
+	}
+}
+
+func TestCheckpointAtIndex(t *testing.T) {
+	ctx := context.Background()
+	const index = 5
+
+	// the first validator is the slowest to respond but its signature must
+	// still come first since signatures are ordered by validator set order
+	slow := newMockCheckpointFetcher(t, index, 200*time.Millisecond)
+	fast := newMockCheckpointFetcher(t, index, 0)
+	stalled := newMockCheckpointFetcher(t, index, time.Hour)
+	missing := newMockCheckpointFetcher(t, index, 0)
+	missing.checkpoint = nil
+
+	r := NewRelayer(nil, nil).(*relayer)
+	fetchers := []CheckpointFetcher{slow, missing, stalled, fast}
+
+	start := time.Now()
+	checkpoint, err := r.checkpointAtIndex(ctx, testOriginDomain, index, fetchers, 2, testMessageID)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), checkpointFetchTimeout, "should return once threshold is reached without waiting for stalled validator")
+
+	require.Len(t, checkpoint.Signatures, 2)
+	assert.Equal(t, slow.checkpoint.Signature, checkpoint.Signatures[0])
+	assert.Equal(t, fast.checkpoint.Signature, checkpoint.Signatures[1])
+
+	// verified checkpoints are cached, so a retry should not refetch them
+	_, err = r.checkpointAtIndex(ctx, testOriginDomain, index, []CheckpointFetcher{slow, fast}, 2, testMessageID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), slow.calls.Load())
+	assert.Equal(t, int64(1), fast.calls.Load())
+}
+
+func TestCheckpointAtIndexNotEnoughSignatures(t *testing.T) {
+	ctx := context.Background()
+	const index = 5
+
+	valid := newMockCheckpointFetcher(t, index, 0)
+	wrongIndex := newMockCheckpointFetcher(t, index+1, 0)
+	missing := newMockCheckpointFetcher(t, index, 0)
+	missing.checkpoint = nil
+
+	r := NewRelayer(nil, nil).(*relayer)
+	_, err := r.checkpointAtIndex(ctx, testOriginDomain, index, []CheckpointFetcher{valid, wrongIndex, missing}, 2, testMessageID)
+	assert.ErrorIs(t, err, ErrNotEnoughSignaturesFound)
+
+	// missing checkpoints are not cached since the validator may sign later
+	_, err = r.checkpointAtIndex(ctx, testOriginDomain, index, []CheckpointFetcher{missing}, 1, testMessageID)
+	assert.ErrorIs(t, err, ErrNotEnoughSignaturesFound)
+	assert.Equal(t, int64(2), missing.calls.Load())
+}
+
+func TestCheckpointAtIndexRejectsInvalidCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	const index = 5
+
+	valid := newMockCheckpointFetcher(t, index, 0)
+
+	// a checkpoint that is validly signed, but not by the validator whose
+	// storage location it was fetched from
+	impersonated := newMockCheckpointFetcher(t, index, 0)
+	impersonated.checkpoint = valid.checkpoint
+
+	wrongDomain := newMockCheckpointFetcher(t, index, 0)
+	wrongDomain.checkpoint.Value.Checkpoint.MailboxDomain = testOriginDomain + 1
+
+	r := NewRelayer(nil, nil).(*relayer)
+	_, err := r.checkpointAtIndex(ctx, testOriginDomain, index, []CheckpointFetcher{valid, impersonated, wrongDomain}, 2, testMessageID)
+	assert.ErrorIs(t, err, ErrNotEnoughSignaturesFound)
+
+	// checkpoints are cached per origin domain, so the same validator and
+	// index on another origin domain is fetched again
+	_, err = r.checkpointAtIndex(ctx, testOriginDomain, index, []CheckpointFetcher{valid}, 1, testMessageID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), valid.calls.Load())
+	_, err = r.checkpointAtIndex(ctx, testOriginDomain+1, index, []CheckpointFetcher{valid}, 1, testMessageID)
+	assert.ErrorIs(t, err, ErrNotEnoughSignaturesFound)
+	assert.Equal(t, int64(2), valid.calls.Load())
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	MERKLE_TREE_DEPTH = 32
+)
+
+// zeroHashes[i] is the root of an empty merkle tree of depth i
+var zeroHashes = func() [MERKLE_TREE_DEPTH][32]byte {
+	var zeroes [MERKLE_TREE_DEPTH][32]byte
+	for i := 1; i < MERKLE_TREE_DEPTH; i++ {
+		zeroes[i] = crypto.Keccak256Hash(zeroes[i-1][:], zeroes[i-1][:])
+	}
+	return zeroes
+}()
+
+// MerkleTree is an incremental merkle tree matching the tree maintained by
+// hyperlanes merkle tree hook, see
+// https://github.com/hyperlane-xyz/hyperlane-monorepo/blob/main/solidity/contracts/libs/Merkle.sol
+type MerkleTree struct {
+	Branch [MERKLE_TREE_DEPTH][32]byte
+	Count  uint64
+}
+
+// Insert inserts a leaf into the next empty slot of the tree
+func (t *MerkleTree) Insert(leaf [32]byte) error {
+	if t.Count >= (1<<MERKLE_TREE_DEPTH)-1 {
+		return fmt.Errorf("merkle tree is full")
+	}
+
+	t.Count++
+	size := t.Count
+	node := leaf
+	for i := 0; i < MERKLE_TREE_DEPTH; i++ {
+		if size&1 == 1 {
+			t.Branch[i] = node
+			return nil
+		}
+		node = crypto.Keccak256Hash(t.Branch[i][:], node[:])
+		size /= 2
+	}
+
+	// unreachable since the tree is not full
+	return fmt.Errorf("inserting leaf into merkle tree")
+}
+
+// Root computes the current root of the tree
+func (t *MerkleTree) Root() [32]byte {
+	var current [32]byte
+	for i := 0; i < MERKLE_TREE_DEPTH; i++ {
+		if (t.Count>>i)&1 == 1 {
+			current = crypto.Keccak256Hash(t.Branch[i][:], current[:])
+		} else {
+			current = crypto.Keccak256Hash(current[:], zeroHashes[i][:])
+		}
+	}
+	return current
+}
+
+// LatestLeafProof returns the merkle proof of the most recently inserted leaf
+// (the leaf at index Count - 1) against the current root of the tree
+func (t *MerkleTree) LatestLeafProof() ([MERKLE_TREE_DEPTH][32]byte, error) {
+	var proof [MERKLE_TREE_DEPTH][32]byte
+	if t.Count == 0 {
+		return proof, fmt.Errorf("no leaves have been inserted into merkle tree")
+	}
+
+	// all left siblings of the latest leaf are complete subtrees stored in
+	// the branch, and all right siblings are empty subtrees
+	index := t.Count - 1
+	for i := 0; i < MERKLE_TREE_DEPTH; i++ {
+		if (index>>i)&1 == 1 {
+			proof[i] = t.Branch[i]
+		} else {
+			proof[i] = zeroHashes[i]
+		}
+	}
+	return proof, nil
+}
+
+// BranchRoot computes the root of a merkle tree given a leaf, its index and
+// its merkle proof
+func BranchRoot(leaf [32]byte, proof [MERKLE_TREE_DEPTH][32]byte, index uint64) [32]byte {
+	current := leaf
+	for i := 0; i < MERKLE_TREE_DEPTH; i++ {
+		if (index>>i)&1 == 1 {
+			current = crypto.Keccak256Hash(proof[i][:], current[:])
+		} else {
+			current = crypto.Keccak256Hash(current[:], proof[i][:])
+		}
+	}
+	return current
+}
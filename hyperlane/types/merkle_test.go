@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleTreeLatestLeafProof(t *testing.T) {
+	var tree MerkleTree
+	for i := uint64(0); i < 37; i++ {
+		leaf := crypto.Keccak256Hash(binary.BigEndian.AppendUint64(nil, i))
+		require.NoError(t, tree.Insert(leaf))
+
+		proof, err := tree.LatestLeafProof()
+		require.NoError(t, err)
+		assert.Equal(t, tree.Root(), BranchRoot(leaf, proof, i), "proof for leaf %d does not match tree root", i)
+	}
+}
+
+func TestMerkleTreeEmpty(t *testing.T) {
+	var tree MerkleTree
+	_, err := tree.LatestLeafProof()
+	assert.Error(t, err)
+
+	var zero [32]byte
+	var proof [MERKLE_TREE_DEPTH][32]byte
+	copy(proof[:], zeroHashes[:])
+	assert.Equal(t, BranchRoot(zero, proof, 0), tree.Root())
+}
+
+func TestAggregationMetadata(t *testing.T) {
+	metadata, err := AggregationMetadata([][]byte{{0x01, 0x02}, nil, {0x03}})
+	require.NoError(t, err)
+
+	expected := []byte{
+		0, 0, 0, 24, 0, 0, 0, 26, // sub module 0
+		0, 0, 0, 0, 0, 0, 0, 0, // sub module 1 has no metadata
+		0, 0, 0, 26, 0, 0, 0, 27, // sub module 2
+		0x01, 0x02, 0x03,
+	}
+	assert.Equal(t, expected, metadata)
+}
+
+func TestToMerkleRootMetadata(t *testing.T) {
+	checkpoint := MultiSigSignedCheckpoint{
+		Checkpoint: CheckpointWithMessageID{
+			Checkpoint: Checkpoint{
+				MerkleTreeHookAddress: "0x" + hexOf(0xaa, 32),
+				Root:                  hexOf(0xbb, 32),
+				Index:                 7,
+			},
+			MessageID: hexOf(0xcc, 32),
+		},
+		Signatures: []Signature{{R: hexOf(0x01, 32), S: hexOf(0x02, 32), V: 27}},
+	}
+	var proof [MERKLE_TREE_DEPTH][32]byte
+	proof[0][0] = 0xdd
+
+	metadata, err := checkpoint.ToMerkleRootMetadata(5, proof)
+	require.NoError(t, err)
+	require.Len(t, metadata, 1096+VALIDATOR_SIGNATURE_LENGTH)
+
+	assert.Equal(t, byte(0xaa), metadata[0])
+	assert.Equal(t, uint32(5), binary.BigEndian.Uint32(metadata[32:36]))
+	assert.Equal(t, byte(0xcc), metadata[36])
+	assert.Equal(t, byte(0xdd), metadata[68])
+	assert.Equal(t, uint32(7), binary.BigEndian.Uint32(metadata[1092:1096]))
+	assert.Equal(t, byte(27), metadata[len(metadata)-1])
+}
+
+func hexOf(b byte, n int) string {
+	return hex.EncodeToString(bytes.Repeat([]byte{b}, n))
+}
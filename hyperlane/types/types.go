@@ -146,7 +146,17 @@ type MultiSigSignedCheckpoint struct {
 const (
 	MERKLE_TREE_ADDRESS_LEN    = 32
 	SIGNED_CHECKPOINT_ROOT_LEN = 32
+	SIGNED_MESSAGE_ID_LEN      = 32
 	VALIDATOR_SIGNATURE_LENGTH = 65
+	AGGREGATION_RANGE_LEN      = 8
+)
+
+// ism types as defined by hyperlane, see
+// https://github.com/hyperlane-xyz/hyperlane-monorepo/blob/main/solidity/contracts/interfaces/IInterchainSecurityModule.sol
+const (
+	ISMTypeAggregation        uint8 = 2
+	ISMTypeMerkleRootMultisig uint8 = 4
+	ISMTypeMessageIDMultisig  uint8 = 5
 )
 
 func (c MultiSigSignedCheckpoint) ToMetadata() ([]byte, error) {
@@ -208,6 +218,116 @@ func (c MultiSigSignedCheckpoint) ToMetadata() ([]byte, error) {
 	return metadata.Bytes(), nil
 }
 
+func (c MultiSigSignedCheckpoint) ToMerkleRootMetadata(messageIndex uint32, proof [MERKLE_TREE_DEPTH][32]byte) ([]byte, error) {
+	/**
+	 * Format of metadata we need to construct:
+	 * [   0:  32] Origin merkle tree address
+	 * [  32:  36] Index of message ID in merkle tree
+	 * [  36:  68] Signed checkpoint message ID
+	 * [  68:1092] Merkle proof
+	 * [1092:1096] Signed checkpoint index (computed from proof and index)
+	 * [1096:????] Validator signatures (length := threshold * 65)
+	 */
+	var buf []byte
+	metadata := bytes.NewBuffer(buf)
+
+	hook, err := hex.DecodeString(strings.TrimPrefix(c.Checkpoint.Checkpoint.MerkleTreeHookAddress, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("decoding hex merkle tree checkpoint address: %w", err)
+	}
+	n, err := metadata.Write(hook)
+	if err != nil {
+		return nil, fmt.Errorf("writing merkle tree contract addr %s to message metadata: %w", c.Checkpoint.Checkpoint.MerkleTreeHookAddress, err)
+	}
+	if n != MERKLE_TREE_ADDRESS_LEN {
+		return nil, fmt.Errorf("invalid length for merkle tree contract addr, expected %d, got %d", MERKLE_TREE_ADDRESS_LEN, n)
+	}
+
+	if err = binary.Write(metadata, binary.BigEndian, messageIndex); err != nil {
+		return nil, fmt.Errorf("writing message index %d to message metadata: %w", messageIndex, err)
+	}
+
+	messageID, err := hex.DecodeString(strings.TrimPrefix(c.Checkpoint.MessageID, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("decoding hex checkpoint message id: %w", err)
+	}
+	n, err = metadata.Write(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("writing signed checkpoint message id %s to message metadata: %w", c.Checkpoint.MessageID, err)
+	}
+	if n != SIGNED_MESSAGE_ID_LEN {
+		return nil, fmt.Errorf("invalid length for signed checkpoint message id, expected %d, got %d", SIGNED_MESSAGE_ID_LEN, n)
+	}
+
+	for _, node := range proof {
+		if _, err = metadata.Write(node[:]); err != nil {
+			return nil, fmt.Errorf("writing merkle proof to message metadata: %w", err)
+		}
+	}
+
+	index := c.Checkpoint.Checkpoint.Index
+	if err = binary.Write(metadata, binary.BigEndian, index); err != nil {
+		return nil, fmt.Errorf("writing signed checkpoint index %d to message metadata: %w", index, err)
+	}
+
+	for _, signature := range c.Signatures {
+		sigBytes, err := signature.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("converting signature to bytes: %w", err)
+		}
+
+		n, err = metadata.Write(sigBytes)
+		if err != nil {
+			return nil, fmt.Errorf("writing signature bytes %s to message metadata: %w", string(sigBytes), err)
+		}
+		if n != VALIDATOR_SIGNATURE_LENGTH {
+			return nil, fmt.Errorf("invalid length for signature, expected %d, got %d", VALIDATOR_SIGNATURE_LENGTH, n)
+		}
+	}
+
+	return metadata.Bytes(), nil
+}
+
+// AggregationMetadata combines the metadata for each sub module of an
+// aggregation ism into a single metadata. A nil entry in subModuleMetadata
+// means that no metadata is being provided for the sub module at that index.
+func AggregationMetadata(subModuleMetadata [][]byte) ([]byte, error) {
+	/**
+	 * Format of metadata we need to construct:
+	 * [   0:   8] Start and end offsets of sub module 0 metadata (uint32 each)
+	 * [   8:  16] Start and end offsets of sub module 1 metadata (uint32 each)
+	 * ...
+	 * [8*n:????] Concatenated sub module metadata
+	 *
+	 * A sub module with no metadata has a start and end offset of 0
+	 */
+	var buf []byte
+	ranges := bytes.NewBuffer(buf)
+	var body []byte
+	metadata := bytes.NewBuffer(body)
+
+	offset := uint32(len(subModuleMetadata) * AGGREGATION_RANGE_LEN)
+	for i, subMetadata := range subModuleMetadata {
+		var start, end uint32
+		if subMetadata != nil {
+			start = offset
+			end = offset + uint32(len(subMetadata))
+			offset = end
+			if _, err := metadata.Write(subMetadata); err != nil {
+				return nil, fmt.Errorf("writing sub module %d metadata to aggregation metadata: %w", i, err)
+			}
+		}
+		if err := binary.Write(ranges, binary.BigEndian, start); err != nil {
+			return nil, fmt.Errorf("writing sub module %d metadata start offset to aggregation metadata: %w", i, err)
+		}
+		if err := binary.Write(ranges, binary.BigEndian, end); err != nil {
+			return nil, fmt.Errorf("writing sub module %d metadata end offset to aggregation metadata: %w", i, err)
+		}
+	}
+
+	return append(ranges.Bytes(), metadata.Bytes()...), nil
+}
+
 type MailboxDispatchEvent struct {
 	Recipient         string
 	Message           string
@@ -0,0 +1,133 @@
+package inventory
+
+import (
+	"math/big"
+	"sync"
+	"time"
+)
+
+// Reservation is an amount of a chains inventory that has been set aside for
+// an order fill that has not yet landed on chain
+type Reservation struct {
+	// OrderID is the db id of the order being filled
+	OrderID int64
+	// SourceChainID is the chain the order being filled was submitted on
+	SourceChainID string
+	// ChainID is the chain the inventory is reserved on, i.e. the orders
+	// destination chain
+	ChainID string
+	Denom   string
+	Amount  *big.Int
+	// TxHash is the hash of the fill tx, empty if the fill has not been
+	// submitted yet
+	TxHash      string
+	SubmittedAt time.Time
+}
+
+// Ledger tracks inventory that is reserved for in flight order fills, so that
+// concurrent fill workers reading the same on chain balance do not commit more
+// inventory than the solver holds
+type Ledger struct {
+	lock         sync.Mutex
+	reservations map[int64]Reservation
+}
+
+func NewLedger() *Ledger {
+	return &Ledger{
+		reservations: make(map[int64]Reservation),
+	}
+}
+
+// Reserve reserves amount of denom on chainID for an order if the solvers
+// balance minus the amount already reserved by other orders covers it. If the
+// order already has a reservation, it is replaced. Returns whether the amount
+// was reserved and the amount that was available to reserve.
+func (l *Ledger) Reserve(orderID int64, sourceChainID, chainID, denom string, amount, balance *big.Int) (bool, *big.Int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	available := new(big.Int).Sub(balance, l.reserved(chainID, denom, orderID))
+	if available.Cmp(amount) < 0 {
+		return false, available
+	}
+
+	l.reservations[orderID] = Reservation{
+		OrderID:       orderID,
+		SourceChainID: sourceChainID,
+		ChainID:       chainID,
+		Denom:         denom,
+		Amount:        new(big.Int).Set(amount),
+	}
+	return true, available
+}
+
+// AttachTx records that the fill tx for an orders reservation has been
+// submitted. The reservation is held until the tx is no longer pending, see
+// ReleaseTx.
+func (l *Ledger) AttachTx(orderID int64, txHash string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	reservation, ok := l.reservations[orderID]
+	if !ok {
+		return
+	}
+	reservation.TxHash = txHash
+	reservation.SubmittedAt = time.Now()
+	l.reservations[orderID] = reservation
+}
+
+// Release releases an orders reservation, if it has one
+func (l *Ledger) Release(orderID int64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.reservations, orderID)
+}
+
+// ReleaseTx releases an orders reservation if it is held for the fill tx with
+// txHash. This is called once the fill tx is no longer pending, since a landed
+// fill is already reflected in the solvers on chain balance.
+func (l *Ledger) ReleaseTx(orderID int64, txHash string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	reservation, ok := l.reservations[orderID]
+	if !ok || reservation.TxHash != txHash {
+		return
+	}
+	delete(l.reservations, orderID)
+}
+
+// Reserved returns the total amount of denom reserved on chainID
+func (l *Ledger) Reserved(chainID, denom string) *big.Int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.reserved(chainID, denom, 0)
+}
+
+// Reservations returns all current reservations
+func (l *Ledger) Reservations() []Reservation {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	reservations := make([]Reservation, 0, len(l.reservations))
+	for _, reservation := range l.reservations {
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
+// reserved sums the reservations of denom on chainID, excluding the
+// reservation for excludeOrderID. The lock must be held.
+func (l *Ledger) reserved(chainID, denom string, excludeOrderID int64) *big.Int {
+	total := big.NewInt(0)
+	for orderID, reservation := range l.reservations {
+		if orderID == excludeOrderID || reservation.ChainID != chainID || reservation.Denom != denom {
+			continue
+		}
+		total.Add(total, reservation.Amount)
+	}
+	return total
+}
@@ -0,0 +1,48 @@
+package inventory
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedgerReserve(t *testing.T) {
+	ledger := NewLedger()
+	balance := big.NewInt(100)
+
+	reserved, available := ledger.Reserve(1, "1", "osmosis-1", "uusdc", big.NewInt(60), balance)
+	assert.True(t, reserved)
+	assert.Equal(t, big.NewInt(100), available)
+
+	// other orders can only reserve the balance not already reserved
+	reserved, available = ledger.Reserve(2, "1", "osmosis-1", "uusdc", big.NewInt(60), balance)
+	assert.False(t, reserved)
+	assert.Equal(t, big.NewInt(40), available)
+
+	// reservations are tracked per chain and denom
+	reserved, _ = ledger.Reserve(2, "osmosis-1", "1", "uusdc", big.NewInt(60), balance)
+	assert.True(t, reserved)
+	assert.Equal(t, big.NewInt(60), ledger.Reserved("osmosis-1", "uusdc"))
+
+	// reserving again for the same order replaces its reservation
+	reserved, _ = ledger.Reserve(1, "1", "osmosis-1", "uusdc", big.NewInt(90), balance)
+	assert.True(t, reserved)
+	assert.Equal(t, big.NewInt(90), ledger.Reserved("osmosis-1", "uusdc"))
+
+	ledger.AttachTx(1, "0xabc")
+	assert.Len(t, ledger.Reservations(), 2)
+
+	// a reservation is only released for the fill tx it is held for
+	ledger.ReleaseTx(1, "0xdef")
+	assert.Equal(t, big.NewInt(90), ledger.Reserved("osmosis-1", "uusdc"))
+	ledger.ReleaseTx(1, "0xabc")
+	assert.Equal(t, big.NewInt(0), ledger.Reserved("osmosis-1", "uusdc"))
+	reserved, _ = ledger.Reserve(1, "1", "osmosis-1", "uusdc", big.NewInt(90), balance)
+	assert.True(t, reserved)
+
+	ledger.Release(1)
+	assert.Equal(t, big.NewInt(0), ledger.Reserved("osmosis-1", "uusdc"))
+	reserved, _ = ledger.Reserve(3, "1", "osmosis-1", "uusdc", big.NewInt(100), balance)
+	assert.True(t, reserved)
+}
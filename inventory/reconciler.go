@@ -0,0 +1,144 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/clientmanager"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"github.com/skip-mev/go-fast-solver/shared/metrics"
+	"go.uber.org/zap"
+)
+
+const (
+	reconcileInterval = 30 * time.Second
+	// untrackedTxTimeout is how long a reservation is held for a submitted fill
+	// tx that was never recorded in the db, after which the tx has either
+	// landed and is reflected in the on chain balance or has been dropped
+	untrackedTxTimeout = 10 * time.Minute
+)
+
+type Database interface {
+	GetSubmittedTxsByOrderIdAndType(ctx context.Context, arg db.GetSubmittedTxsByOrderIdAndTypeParams) ([]db.SubmittedTx, error)
+}
+
+// Reconciler periodically releases ledger reservations for fill txs that are
+// no longer pending and reconciles the reserved amounts against the solvers
+// on chain balances
+type Reconciler struct {
+	db            Database
+	clientManager *clientmanager.ClientManager
+	ledger        *Ledger
+}
+
+func NewReconciler(db Database, clientManager *clientmanager.ClientManager, ledger *Ledger) *Reconciler {
+	return &Reconciler{
+		db:            db,
+		clientManager: clientManager,
+		ledger:        ledger,
+	}
+}
+
+func (r *Reconciler) Start(ctx context.Context) error {
+	lmt.Logger(ctx).Info("Starting inventory reconciler")
+	var chains []config.ChainConfig
+	evmChains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(config.ChainType_EVM)
+	if err != nil {
+		return fmt.Errorf("error getting EVM chains: %w", err)
+	}
+	cosmosChains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(config.ChainType_COSMOS)
+	if err != nil {
+		return fmt.Errorf("error getting cosmos chains: %w", err)
+	}
+	chains = append(chains, evmChains...)
+	chains = append(chains, cosmosChains...)
+
+	ticker := time.NewTicker(reconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.releaseLandedReservations(ctx)
+			for _, chain := range chains {
+				if err := r.reconcileChain(ctx, chain); err != nil {
+					lmt.Logger(ctx).Error("failed to reconcile inventory", zap.String("chain_id", chain.ChainID), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// releaseLandedReservations releases the reservations of submitted fill txs
+// that have succeeded, failed or been abandoned. The tx verifier releases
+// reservations as soon as it updates a fill txs status, so this only catches
+// reservations it missed, such as those of fill txs that were never recorded.
+func (r *Reconciler) releaseLandedReservations(ctx context.Context) {
+	for _, reservation := range r.ledger.Reservations() {
+		if reservation.TxHash == "" {
+			// the fill has not been submitted yet, the fill handler releases
+			// the reservation if it is not submitted
+			continue
+		}
+
+		fillTxs, err := r.db.GetSubmittedTxsByOrderIdAndType(ctx, db.GetSubmittedTxsByOrderIdAndTypeParams{
+			OrderID: sql.NullInt64{Int64: reservation.OrderID, Valid: true},
+			TxType:  dbtypes.TxTypeOrderFill,
+		})
+		if err != nil {
+			lmt.Logger(ctx).Error("failed to get submitted txs for reservation", zap.Int64("orderID", reservation.OrderID), zap.Error(err))
+			continue
+		}
+
+		var tracked bool
+		for _, tx := range fillTxs {
+			if tx.TxHash != reservation.TxHash {
+				continue
+			}
+			tracked = true
+			if tx.TxStatus != dbtypes.TxStatusPending {
+				r.ledger.Release(reservation.OrderID)
+			}
+		}
+		if !tracked && time.Since(reservation.SubmittedAt) > untrackedTxTimeout {
+			lmt.Logger(ctx).Warn(
+				"releasing reservation for untracked fill tx",
+				zap.Int64("orderID", reservation.OrderID),
+				zap.String("txHash", reservation.TxHash),
+			)
+			r.ledger.Release(reservation.OrderID)
+		}
+	}
+}
+
+// reconcileChain compares the amount reserved on a chain with the solvers
+// on chain balance and exports both as metrics
+func (r *Reconciler) reconcileChain(ctx context.Context, chain config.ChainConfig) error {
+	client, err := r.clientManager.GetClient(ctx, chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	balance, err := client.Balance(ctx, chain.SolverAddress, chain.USDCDenom)
+	if err != nil {
+		return fmt.Errorf("getting balance: %w", err)
+	}
+
+	reserved := r.ledger.Reserved(chain.ChainID, chain.USDCDenom)
+	if reserved.Cmp(balance) > 0 {
+		lmt.Logger(ctx).Warn(
+			"reserved inventory exceeds on chain balance",
+			zap.String("chainID", chain.ChainID),
+			zap.String("denom", chain.USDCDenom),
+			zap.String("balance", balance.String()),
+			zap.String("reserved", reserved.String()),
+		)
+	}
+	metrics.FromContext(ctx).SetInventory(chain.ChainID, chain.USDCDenom, *balance, *reserved)
+	return nil
+}
@@ -396,6 +396,63 @@ func (_c *MockConfigReader_GetCoingeckoConfig_Call) RunAndReturn(run func() conf
 	return _c
 }
 
+// GetFillPolicy provides a mock function with given fields: sourceChainID, destinationChainID
+func (_m *MockConfigReader) GetFillPolicy(sourceChainID string, destinationChainID string) (config.FillPolicy, error) {
+	ret := _m.Called(sourceChainID, destinationChainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFillPolicy")
+	}
+
+	var r0 config.FillPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (config.FillPolicy, error)); ok {
+		return rf(sourceChainID, destinationChainID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) config.FillPolicy); ok {
+		r0 = rf(sourceChainID, destinationChainID)
+	} else {
+		r0 = ret.Get(0).(config.FillPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(sourceChainID, destinationChainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfigReader_GetFillPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFillPolicy'
+type MockConfigReader_GetFillPolicy_Call struct {
+	*mock.Call
+}
+
+// GetFillPolicy is a helper method to define mock.On call
+//   - sourceChainID string
+//   - destinationChainID string
+func (_e *MockConfigReader_Expecter) GetFillPolicy(sourceChainID interface{}, destinationChainID interface{}) *MockConfigReader_GetFillPolicy_Call {
+	return &MockConfigReader_GetFillPolicy_Call{Call: _e.mock.On("GetFillPolicy", sourceChainID, destinationChainID)}
+}
+
+func (_c *MockConfigReader_GetFillPolicy_Call) Run(run func(sourceChainID string, destinationChainID string)) *MockConfigReader_GetFillPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConfigReader_GetFillPolicy_Call) Return(_a0 config.FillPolicy, _a1 error) *MockConfigReader_GetFillPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfigReader_GetFillPolicy_Call) RunAndReturn(run func(string, string) (config.FillPolicy, error)) *MockConfigReader_GetFillPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFundRebalancingConfig provides a mock function with given fields: chainID
 func (_m *MockConfigReader) GetFundRebalancingConfig(chainID string) (config.FundRebalancerConfig, error) {
 	ret := _m.Called(chainID)
@@ -631,6 +688,63 @@ func (_c *MockConfigReader_GetRPCEndpoint_Call) RunAndReturn(run func(string) (s
 	return _c
 }
 
+// GetRouteConfig provides a mock function with given fields: sourceChainID, destinationChainID
+func (_m *MockConfigReader) GetRouteConfig(sourceChainID string, destinationChainID string) (config.RouteConfig, error) {
+	ret := _m.Called(sourceChainID, destinationChainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRouteConfig")
+	}
+
+	var r0 config.RouteConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (config.RouteConfig, error)); ok {
+		return rf(sourceChainID, destinationChainID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) config.RouteConfig); ok {
+		r0 = rf(sourceChainID, destinationChainID)
+	} else {
+		r0 = ret.Get(0).(config.RouteConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(sourceChainID, destinationChainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConfigReader_GetRouteConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRouteConfig'
+type MockConfigReader_GetRouteConfig_Call struct {
+	*mock.Call
+}
+
+// GetRouteConfig is a helper method to define mock.On call
+//   - sourceChainID string
+//   - destinationChainID string
+func (_e *MockConfigReader_Expecter) GetRouteConfig(sourceChainID interface{}, destinationChainID interface{}) *MockConfigReader_GetRouteConfig_Call {
+	return &MockConfigReader_GetRouteConfig_Call{Call: _e.mock.On("GetRouteConfig", sourceChainID, destinationChainID)}
+}
+
+func (_c *MockConfigReader_GetRouteConfig_Call) Run(run func(sourceChainID string, destinationChainID string)) *MockConfigReader_GetRouteConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockConfigReader_GetRouteConfig_Call) Return(_a0 config.RouteConfig, _a1 error) *MockConfigReader_GetRouteConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConfigReader_GetRouteConfig_Call) RunAndReturn(run func(string, string) (config.RouteConfig, error)) *MockConfigReader_GetRouteConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUSDCDenom provides a mock function with given fields: chainID
 func (_m *MockConfigReader) GetUSDCDenom(chainID string) (string, error) {
 	ret := _m.Called(chainID)
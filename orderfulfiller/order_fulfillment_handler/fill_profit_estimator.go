@@ -0,0 +1,126 @@
+package order_fulfillment_handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/oracle"
+)
+
+// FillProfitEstimate is a breakdown of the net profit that the solver expects
+// to make by filling an order. All amounts are in uusdc.
+type FillProfitEstimate struct {
+	// SolverFee is the orders amount in - amount out
+	SolverFee *big.Int
+	// FillTxCost is the simulated cost of the fill tx on the destination chain
+	FillTxCost *big.Int
+	// SettlementCostShare is the orders share of the expected cost of
+	// settling the batch it will be included in and relaying the settlement
+	SettlementCostShare *big.Int
+	// NetProfit is SolverFee - FillTxCost - SettlementCostShare
+	NetProfit *big.Int
+}
+
+type FillProfitEstimator struct {
+	txPriceOracle oracle.TxPriceOracle
+}
+
+func NewFillProfitEstimator(txPriceOracle oracle.TxPriceOracle) *FillProfitEstimator {
+	return &FillProfitEstimator{txPriceOracle: txPriceOracle}
+}
+
+// EstimateFillProfit simulates filling order on the destination chain and
+// estimates the net profit the solver will make from the order once the fill
+// and the orders share of the settlement have landed on chain.
+func (e *FillProfitEstimator) EstimateFillProfit(
+	ctx context.Context,
+	destinationChainBridgeClient cctp.BridgeClient,
+	destinationChainGatewayContractAddress string,
+	order db.Order,
+) (FillProfitEstimate, error) {
+	amountIn, ok := new(big.Int).SetString(order.AmountIn, 10)
+	if !ok {
+		return FillProfitEstimate{}, fmt.Errorf("converting amount in %s to *big.Int", order.AmountIn)
+	}
+	amountOut, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return FillProfitEstimate{}, fmt.Errorf("converting amount out %s to *big.Int", order.AmountOut)
+	}
+
+	txFee, err := destinationChainBridgeClient.EstimateFillOrderTxFee(ctx, order, destinationChainGatewayContractAddress)
+	if err != nil {
+		return FillProfitEstimate{}, fmt.Errorf("estimating fill order tx fee on chain %s: %w", order.DestinationChainID, err)
+	}
+	fillTxCost, err := e.txPriceOracle.GasCostUUSDC(ctx, txFee, order.DestinationChainID)
+	if err != nil {
+		return FillProfitEstimate{}, fmt.Errorf("converting fill order tx fee %s on chain %s to uusdc: %w", txFee.String(), order.DestinationChainID, err)
+	}
+
+	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
+	if err != nil {
+		return FillProfitEstimate{}, fmt.Errorf("getting config for chainID %s: %w", order.SourceChainID, err)
+	}
+	routeConfig, err := config.GetConfigReader(ctx).GetRouteConfig(order.SourceChainID, order.DestinationChainID)
+	if err != nil {
+		return FillProfitEstimate{}, fmt.Errorf("getting route config from chainID %s to %s: %w", order.SourceChainID, order.DestinationChainID, err)
+	}
+	settlementCostShare, err := SettlementCostShareUUSDC(amountIn, routeConfig, sourceChainConfig.BatchUUSDCSettleUpThreshold)
+	if err != nil {
+		return FillProfitEstimate{}, fmt.Errorf("estimating settlement cost share for order %s: %w", order.OrderID, err)
+	}
+
+	solverFee := new(big.Int).Sub(amountIn, amountOut)
+	netProfit := new(big.Int).Sub(solverFee, fillTxCost)
+	netProfit.Sub(netProfit, settlementCostShare)
+
+	return FillProfitEstimate{
+		SolverFee:           solverFee,
+		FillTxCost:          fillTxCost,
+		SettlementCostShare: settlementCostShare,
+		NetProfit:           netProfit,
+	}, nil
+}
+
+// SettlementCostShareUUSDC returns the share of a routes expected settlement
+// and relay cost that an order with amountIn will bear. Settlements are
+// initiated once batchUUSDCSettleUpThreshold uusdc worth of orders have been
+// filled, so each order bears the cost proportional to its amount in relative
+// to the threshold (rounded up). Orders larger than the threshold are settled
+// on their own and bear the full cost.
+func SettlementCostShareUUSDC(amountIn *big.Int, routeConfig config.RouteConfig, batchUUSDCSettleUpThreshold string) (*big.Int, error) {
+	batchCost := big.NewInt(0)
+	for _, cost := range []string{routeConfig.ExpectedSettlementCostUUSDC, routeConfig.ExpectedRelayCostUUSDC} {
+		if cost == "" {
+			continue
+		}
+		c, ok := new(big.Int).SetString(cost, 10)
+		if !ok {
+			return nil, fmt.Errorf("converting expected cost %s to *big.Int", cost)
+		}
+		batchCost.Add(batchCost, c)
+	}
+	if batchCost.Sign() == 0 {
+		return batchCost, nil
+	}
+
+	threshold := big.NewInt(0)
+	if batchUUSDCSettleUpThreshold != "" {
+		var ok bool
+		threshold, ok = new(big.Int).SetString(batchUUSDCSettleUpThreshold, 10)
+		if !ok {
+			return nil, fmt.Errorf("converting batch settle up threshold %s to *big.Int", batchUUSDCSettleUpThreshold)
+		}
+	}
+	if threshold.Sign() <= 0 || amountIn.Cmp(threshold) >= 0 {
+		return batchCost, nil
+	}
+
+	// ceil(batchCost * amountIn / threshold)
+	share := new(big.Int).Mul(batchCost, amountIn)
+	share.Add(share, new(big.Int).Sub(threshold, big.NewInt(1)))
+	return share.Div(share, threshold), nil
+}
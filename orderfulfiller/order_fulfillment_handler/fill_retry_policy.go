@@ -0,0 +1,81 @@
+package order_fulfillment_handler
+
+import (
+	"time"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+)
+
+const (
+	defaultMaxFillAttempts  = 3
+	defaultFillRetryBackoff = 30 * time.Second
+)
+
+// FillRetryPolicy determines when a fill tx should be resubmitted for an
+// order whose previous fill txs did not land on chain
+type FillRetryPolicy struct {
+	// MaxAttempts is the max number of fill txs to submit for an order
+	MaxAttempts int
+	// Backoff is the delay after the latest failed attempt before the next
+	// attempt is made, doubling after each failed attempt
+	Backoff time.Duration
+}
+
+// FillRetryPolicyFromConfig creates a FillRetryPolicy from the order filler
+// config, using defaults for any unset values
+func FillRetryPolicyFromConfig(cfg config.OrderFillerConfig) FillRetryPolicy {
+	policy := FillRetryPolicy{
+		MaxAttempts: cfg.MaxFillAttempts,
+		Backoff:     cfg.FillRetryBackoff,
+	}
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultMaxFillAttempts
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = defaultFillRetryBackoff
+	}
+	return policy
+}
+
+// ShouldSubmitFill returns true if a new fill tx should be submitted for an
+// order given the fill txs that have already been submitted for it. A new
+// fill is never submitted while a previous fill tx is pending, since it may
+// still land on chain, or if a previous fill tx succeeded. If the latest fill
+// tx failed or was abandoned but the order has no attempts remaining,
+// attemptsExhausted is true.
+func (p FillRetryPolicy) ShouldSubmitFill(fillTxs []db.SubmittedTx, now time.Time) (submit bool, attemptsExhausted bool) {
+	if len(fillTxs) == 0 {
+		return true, false
+	}
+
+	for _, tx := range fillTxs {
+		if tx.TxStatus != dbtypes.TxStatusFailed && tx.TxStatus != dbtypes.TxStatusAbandoned {
+			// a previous fill is pending or has succeeded
+			return false, false
+		}
+	}
+
+	if len(fillTxs) >= p.MaxAttempts {
+		return false, true
+	}
+
+	backoff := p.Backoff * time.Duration(1<<(len(fillTxs)-1))
+	latest := latestSubmittedTx(fillTxs)
+	if now.Before(latest.UpdatedAt.Add(backoff)) {
+		return false, false
+	}
+	return true, false
+}
+
+// latestSubmittedTx returns the most recently submitted tx in txs
+func latestSubmittedTx(txs []db.SubmittedTx) db.SubmittedTx {
+	var latest db.SubmittedTx
+	for _, tx := range txs {
+		if tx.ID > latest.ID {
+			latest = tx
+		}
+	}
+	return latest
+}
@@ -10,9 +10,13 @@ import (
 	"time"
 
 	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/exposure"
+	"github.com/skip-mev/go-fast-solver/inventory"
 	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
 	"github.com/skip-mev/go-fast-solver/shared/clientmanager"
 	"github.com/skip-mev/go-fast-solver/shared/metrics"
+	"github.com/skip-mev/go-fast-solver/shared/oracle"
+	"github.com/skip-mev/go-fast-solver/shared/screening"
 
 	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	"github.com/skip-mev/go-fast-solver/shared/config"
@@ -41,19 +45,56 @@ type Database interface {
 }
 
 type orderFulfillmentHandler struct {
-	db            Database
-	clientManager *clientmanager.ClientManager
-	relayer       Relayer
+	db                  Database
+	clientManager       *clientmanager.ClientManager
+	relayer             Relayer
+	fillProfitEstimator *FillProfitEstimator
+	inventory           *inventory.Ledger
+	screener            *screening.Screener
+	exposureTracker     *exposure.Tracker
 }
 
-func NewOrderFulfillmentHandler(db Database, clientManager *clientmanager.ClientManager, relayer Relayer) *orderFulfillmentHandler {
+func NewOrderFulfillmentHandler(db Database, clientManager *clientmanager.ClientManager, relayer Relayer, txPriceOracle oracle.TxPriceOracle, inventory *inventory.Ledger, screener *screening.Screener, exposureTracker *exposure.Tracker) *orderFulfillmentHandler {
 	return &orderFulfillmentHandler{
-		db:            db,
-		clientManager: clientManager,
-		relayer:       relayer,
+		db:                  db,
+		clientManager:       clientManager,
+		relayer:             relayer,
+		fillProfitEstimator: NewFillProfitEstimator(txPriceOracle),
+		inventory:           inventory,
+		screener:            screener,
+		exposureTracker:     exposureTracker,
 	}
 }
 
+// gatewayApprover is implemented by bridge clients whose gateway contract must
+// be approved to transfer the solvers usdc before orders can be filled
+type gatewayApprover interface {
+	ApproveGateway(ctx context.Context, gatewayContractAddress string) error
+}
+
+// ApproveGateways approves the gateway contract on each evm chain to transfer
+// the solvers usdc, so that approval txs are not sent while estimating fills
+func (r *orderFulfillmentHandler) ApproveGateways(ctx context.Context) error {
+	evmChains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(config.ChainType_EVM)
+	if err != nil {
+		return fmt.Errorf("getting evm chains: %w", err)
+	}
+	for _, chain := range evmChains {
+		bridgeClient, err := r.clientManager.GetClient(ctx, chain.ChainID)
+		if err != nil {
+			return fmt.Errorf("getting client for chainID %s: %w", chain.ChainID, err)
+		}
+		approver, ok := bridgeClient.(gatewayApprover)
+		if !ok {
+			continue
+		}
+		if err := approver.ApproveGateway(ctx, chain.FastTransferContractAddress); err != nil {
+			return fmt.Errorf("approving gateway on chainID %s: %w", chain.ChainID, err)
+		}
+	}
+	return nil
+}
+
 // TODO: feels like this functions is doing too many different things and the
 // naming is confusing
 func (r *orderFulfillmentHandler) UpdateFulfillmentStatus(ctx context.Context, order db.Order) (fulfillmentStatus string, err error) {
@@ -83,7 +124,7 @@ func (r *orderFulfillmentHandler) UpdateFulfillmentStatus(ctx context.Context, o
 		metrics.FromContext(ctx).ObserveFillLatency(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusFilled, time.Since(order.CreatedAt))
 
 		if _, err := r.db.SetFillTx(ctx, db.SetFillTxParams{
-			FillTx:                            sql.NullString{String: orderFillEvent.TxHash, Valid: true},
+			FillTx:                            sql.NullString{String: orderFillEvent.TxHash, Valid: orderFillEvent.TxHash != ""},
 			Filler:                            sql.NullString{String: orderFillEvent.Filler, Valid: true},
 			SourceChainID:                     order.SourceChainID,
 			OrderID:                           order.OrderID,
@@ -161,10 +202,6 @@ func (r *orderFulfillmentHandler) FillOrder(
 		return "", fmt.Errorf("failed to get client: %w", err)
 	}
 
-	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
-	if err != nil {
-		return "", err
-	}
 	destinationChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.DestinationChainID)
 	if err != nil {
 		return "", err
@@ -173,46 +210,81 @@ func (r *orderFulfillmentHandler) FillOrder(
 	if err != nil {
 		return "", err
 	}
+	fillPolicy, err := config.GetConfigReader(ctx).GetFillPolicy(order.SourceChainID, order.DestinationChainID)
+	if err != nil {
+		return "", fmt.Errorf("getting fill policy for route from chainID %s to %s: %w", order.SourceChainID, order.DestinationChainID, err)
+	}
+
+	if allowed, err := r.checkRoutePolicy(ctx, fillPolicy, order); err != nil {
+		return "", fmt.Errorf("checking route policy for order %s: %w", order.OrderID, err)
+	} else if !allowed {
+		return "", nil
+	}
 
-	if withinTransferLimits, err := r.checkTransferSize(ctx, destinationChainConfig, order); err != nil {
+	if allowed, err := r.checkScreening(ctx, order); err != nil {
+		return "", fmt.Errorf("screening order %s: %w", order.OrderID, err)
+	} else if !allowed {
+		return "", nil
+	}
+
+	if withinTransferLimits, err := r.checkTransferSize(ctx, fillPolicy, order); err != nil {
 		return "", fmt.Errorf("checking transfer size for order %s: %w", order.OrderID, err)
 	} else if !withinTransferLimits {
 		return "", nil
 	}
 
-	if acceptableFee, err := r.checkFeeAmount(ctx, order); err != nil {
+	if acceptableFee, err := r.checkFeeAmount(ctx, fillPolicy, order); err != nil {
 		return "", fmt.Errorf("checking fee amount for order %s: %w", order.OrderID, err)
 	} else if !acceptableFee {
 		return "", nil
 	}
 
+	if shouldSubmit, err := r.checkFillAttempts(ctx, order); err != nil {
+		return "", fmt.Errorf("checking previous fill attempts for order %s: %w", order.OrderID, err)
+	} else if !shouldSubmit {
+		return "", nil
+	}
+
 	if adequateBalance, err := r.checkOrderAssetBalance(ctx, destinationChainBridgeClient, destinationChainConfig, order); err != nil {
 		return "", fmt.Errorf("failed to check balance: %w", err)
 	} else if !adequateBalance {
 		return "", fmt.Errorf("insufficient balance")
 	}
+	// the reservation made by checkOrderAssetBalance is held until the fill tx
+	// is no longer pending, or released here if the fill is not submitted
+	submitted := false
+	defer func() {
+		if !submitted {
+			r.inventory.Release(order.ID)
+		}
+	}()
 
-	if submittedTxs, err := r.db.GetSubmittedTxsByOrderIdAndType(ctx, db.GetSubmittedTxsByOrderIdAndTypeParams{
-		OrderID: sql.NullInt64{Int64: order.ID, Valid: true},
-		TxType:  dbtypes.TxTypeOrderFill,
-	}); err != nil {
-		return "", fmt.Errorf("failed to get submitted txs: %w", err)
-	} else if len(submittedTxs) > 0 { // TODO will want to add some retry logic where even if this is > 0, we want to execute an order fill
+	if withinCaps, err := r.checkExposure(ctx, order); err != nil {
+		return "", fmt.Errorf("checking unsettled exposure for order %s: %w", order.OrderID, err)
+	} else if !withinCaps {
 		return "", nil
 	}
 
-	confirmed, err := r.checkBlockConfirmations(ctx, sourceChainConfig, sourceChainBridgeClient, order)
+	confirmed, err := r.checkBlockConfirmations(ctx, fillPolicy, sourceChainBridgeClient, order)
 	if err != nil {
 		return "", fmt.Errorf("failed to check block confirmations: %w", err)
 	} else if !confirmed {
 		return "", nil
 	}
 
+	if profitable, err := r.checkFillProfit(ctx, destinationChainBridgeClient, destinationChainGatewayContractAddress, order); err != nil {
+		return "", fmt.Errorf("checking fill profit for order %s: %w", order.OrderID, err)
+	} else if !profitable {
+		return "", nil
+	}
+
 	txHash, rawTx, _, err := destinationChainBridgeClient.FillOrder(ctx, order, destinationChainGatewayContractAddress)
 	metrics.FromContext(ctx).IncTransactionSubmitted(err == nil, order.DestinationChainID, dbtypes.TxTypeOrderFill)
 	if err != nil {
 		return "", fmt.Errorf("filling order on destination chain at address %s: %w", destinationChainGatewayContractAddress, err)
 	}
+	submitted = true
+	r.inventory.AttachTx(order.ID, txHash)
 
 	if _, err := r.db.InsertSubmittedTx(ctx, db.InsertSubmittedTxParams{
 		OrderID:  sql.NullInt64{Int64: order.ID, Valid: true},
@@ -228,6 +300,10 @@ func (r *orderFulfillmentHandler) FillOrder(
 	return txHash, nil
 }
 
+// checkOrderAssetBalance reserves the orders amount out from the solvers
+// balance on the destination chain in the inventory ledger, so that concurrent
+// fills do not commit more than the solvers balance. Returns false if the
+// balance not already reserved for other fills does not cover the order.
 func (r *orderFulfillmentHandler) checkOrderAssetBalance(ctx context.Context, destinationChainBridgeClient cctp.BridgeClient, destinationChainConfig config.ChainConfig, orderFill db.Order) (adequateBalance bool, err error) {
 	balance, err := destinationChainBridgeClient.Balance(ctx, destinationChainConfig.SolverAddress, destinationChainConfig.USDCDenom)
 	if err != nil {
@@ -237,18 +313,130 @@ func (r *orderFulfillmentHandler) checkOrderAssetBalance(ctx context.Context, de
 	if err != nil {
 		return false, err
 	}
-	if balance.Cmp(new(big.Int).SetUint64(transferAmount)) < 0 {
-		lmt.Logger(ctx).Warn("insufficient balance", zap.String("balance", balance.String()), zap.Uint64("transferAmount", transferAmount))
+	reserved, available := r.inventory.Reserve(
+		orderFill.ID,
+		orderFill.SourceChainID,
+		destinationChainConfig.ChainID,
+		destinationChainConfig.USDCDenom,
+		new(big.Int).SetUint64(transferAmount),
+		balance,
+	)
+	if !reserved {
+		lmt.Logger(ctx).Warn(
+			"insufficient balance",
+			zap.String("balance", balance.String()),
+			zap.String("unreservedBalance", available.String()),
+			zap.Uint64("transferAmount", transferAmount),
+		)
 		metrics.FromContext(ctx).ObserveInsufficientBalanceError(
 			destinationChainConfig.ChainID,
-			new(big.Int).Sub(new(big.Int).SetUint64(transferAmount), balance).Uint64(),
+			new(big.Int).Sub(new(big.Int).SetUint64(transferAmount), available).Uint64(),
 		)
 		return false, nil
 	}
 	return true, nil
 }
 
-func (r *orderFulfillmentHandler) checkTransferSize(ctx context.Context, destinationChainConfig config.ChainConfig, orderFill db.Order) (withinTransferLimits bool, err error) {
+// checkExposure checks that filling the order would not take the solvers
+// unsettled exposure over any of the configured caps. Orders that would exceed
+// a cap are left pending, so that filling on the route resumes once
+// settlements complete and exposure drops.
+func (r *orderFulfillmentHandler) checkExposure(ctx context.Context, orderFill db.Order) (bool, error) {
+	allowed, capType, reason, err := r.exposureTracker.CheckFill(ctx, orderFill)
+	if err != nil {
+		return false, err
+	}
+	if allowed {
+		return true, nil
+	}
+
+	metrics.FromContext(ctx).IncExposureCapReached(orderFill.SourceChainID, orderFill.DestinationChainID, capType)
+	lmt.Logger(ctx).Warn(
+		"not filling order, "+reason,
+		zap.String("orderID", orderFill.OrderID),
+		zap.String("sourceChainID", orderFill.SourceChainID),
+		zap.String("destinationChainID", orderFill.DestinationChainID),
+		zap.String("capType", capType),
+	)
+	return false, nil
+}
+
+// checkFillAttempts checks if a fill tx should be submitted for an order
+// based on the status of the fill txs that have already been submitted for it
+// and the fill retry policy. If the order has used all of its fill attempts
+// without a fill landing on chain, the orders state will be set to abandoned
+// in the db.
+func (r *orderFulfillmentHandler) checkFillAttempts(ctx context.Context, orderFill db.Order) (bool, error) {
+	fillTxs, err := r.db.GetSubmittedTxsByOrderIdAndType(ctx, db.GetSubmittedTxsByOrderIdAndTypeParams{
+		OrderID: sql.NullInt64{Int64: orderFill.ID, Valid: true},
+		TxType:  dbtypes.TxTypeOrderFill,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get submitted txs: %w", err)
+	}
+
+	retryPolicy := FillRetryPolicyFromConfig(config.GetConfigReader(ctx).Config().OrderFillerConfig)
+	shouldSubmit, attemptsExhausted := retryPolicy.ShouldSubmitFill(fillTxs, time.Now())
+	if shouldSubmit {
+		if len(fillTxs) > 0 {
+			latest := latestSubmittedTx(fillTxs)
+			lmt.Logger(ctx).Info(
+				"retrying order fill",
+				zap.String("orderID", orderFill.OrderID),
+				zap.String("sourceChainID", orderFill.SourceChainID),
+				zap.Int("attempt", len(fillTxs)+1),
+				zap.String("previousFillTxHash", latest.TxHash),
+				zap.String("previousFillTxStatus", latest.TxStatus),
+			)
+		}
+		return true, nil
+	}
+	if !attemptsExhausted {
+		return false, nil
+	}
+
+	latest := latestSubmittedTx(fillTxs)
+	abandonmentReason := fmt.Sprintf(
+		"order fill did not land after %d attempts, latest fill tx %s has status %s",
+		len(fillTxs), latest.TxHash, latest.TxStatus,
+	)
+	if latest.TxStatusMessage.Valid {
+		abandonmentReason += ": " + latest.TxStatusMessage.String
+	}
+
+	return false, r.abandonOrder(ctx, orderFill, abandonmentReason)
+}
+
+// checkRoutePolicy checks if the orders route is enabled and that the order
+// is not older than the routes max order age. If it is not, the orders state
+// will be set to abandoned in the db.
+func (r *orderFulfillmentHandler) checkRoutePolicy(ctx context.Context, fillPolicy config.FillPolicy, orderFill db.Order) (bool, error) {
+	var abandonmentReason string
+	switch {
+	case !fillPolicy.Enabled:
+		abandonmentReason = fmt.Sprintf("route from chain %s to chain %s is disabled", orderFill.SourceChainID, orderFill.DestinationChainID)
+	case fillPolicy.MaxOrderAge > 0 && time.Since(orderFill.CreatedAt) > fillPolicy.MaxOrderAge:
+		abandonmentReason = fmt.Sprintf("order is older than configured max order age of %s", fillPolicy.MaxOrderAge.String())
+	default:
+		return true, nil
+	}
+
+	return false, r.abandonOrder(ctx, orderFill, abandonmentReason, zap.Time("orderCreatedAt", orderFill.CreatedAt))
+}
+
+// checkScreening checks the orders addresses against the screening lists. If
+// any of them are blocked, the orders state will be set to abandoned in the
+// db.
+func (r *orderFulfillmentHandler) checkScreening(ctx context.Context, orderFill db.Order) (bool, error) {
+	allowed, abandonmentReason := r.screener.ScreenOrder(orderFill)
+	if allowed {
+		return true, nil
+	}
+
+	return false, r.abandonOrder(ctx, orderFill, abandonmentReason)
+}
+
+func (r *orderFulfillmentHandler) checkTransferSize(ctx context.Context, fillPolicy config.FillPolicy, orderFill db.Order) (withinTransferLimits bool, err error) {
 	amountIn, ok := new(big.Int).SetString(orderFill.AmountIn, 10)
 	if !ok {
 		return false, fmt.Errorf("could not convert order amount in %s to *big.Int", orderFill.AmountIn)
@@ -257,100 +445,166 @@ func (r *orderFulfillmentHandler) checkTransferSize(ctx context.Context, destina
 	var abandonmentReason string
 	var amountOutOfRange int64
 	switch {
-	case amountIn.Cmp(destinationChainConfig.Cosmos.MinFillSize) < 0:
-		abandonmentReason = "transfer amount is below configured min fill size for chain " + orderFill.DestinationChainID
-		amountOutOfRange = amountIn.Sub(amountIn, destinationChainConfig.Cosmos.MinFillSize).Int64()
-	case amountIn.Cmp(destinationChainConfig.Cosmos.MaxFillSize) > 0:
-		abandonmentReason = "transfer amount exceeds configured max fill size for chain" + orderFill.DestinationChainID
-		amountOutOfRange = amountIn.Sub(amountIn, destinationChainConfig.Cosmos.MaxFillSize).Int64()
+	case fillPolicy.MinFillSize != nil && amountIn.Cmp(fillPolicy.MinFillSize) < 0:
+		abandonmentReason = fmt.Sprintf("transfer amount is below configured min fill size for route from chain %s to chain %s", orderFill.SourceChainID, orderFill.DestinationChainID)
+		amountOutOfRange = new(big.Int).Sub(amountIn, fillPolicy.MinFillSize).Int64()
+	case fillPolicy.MaxFillSize != nil && amountIn.Cmp(fillPolicy.MaxFillSize) > 0:
+		abandonmentReason = fmt.Sprintf("transfer amount exceeds configured max fill size for route from chain %s to chain %s", orderFill.SourceChainID, orderFill.DestinationChainID)
+		amountOutOfRange = new(big.Int).Sub(amountIn, fillPolicy.MaxFillSize).Int64()
 	default:
 		return true, nil
 	}
 
-	lmt.Logger(ctx).Info(
-		"abandoning transaction due to amount exceeding max fill size",
-		zap.String("orderID", orderFill.OrderID),
-		zap.String("sourceChainID", orderFill.SourceChainID),
+	if err := r.abandonOrder(
+		ctx,
+		orderFill,
+		abandonmentReason,
 		zap.String("orderAmountIn", orderFill.AmountIn),
-		zap.String("minAllowedFillSize", destinationChainConfig.Cosmos.MinFillSize.String()),
-		zap.String("maxAllowedFillSize", destinationChainConfig.Cosmos.MaxFillSize.String()),
+		zap.Stringer("minAllowedFillSize", fillPolicy.MinFillSize),
+		zap.Stringer("maxAllowedFillSize", fillPolicy.MaxFillSize),
+	); err != nil {
+		return false, err
+	}
+
+	metrics.FromContext(ctx).ObserveTransferSizeOutOfRange(
+		orderFill.SourceChainID,
+		orderFill.DestinationChainID,
+		amountOutOfRange,
 	)
+	return false, nil
+}
 
-	_, err = r.db.SetOrderStatus(ctx, db.SetOrderStatusParams{
-		SourceChainID:                     orderFill.SourceChainID,
-		OrderID:                           orderFill.OrderID,
-		SourceChainGatewayContractAddress: orderFill.SourceChainGatewayContractAddress,
-		OrderStatus:                       dbtypes.OrderStatusAbandoned,
-		OrderStatusMessage:                sql.NullString{String: abandonmentReason, Valid: true},
-	})
+// checkFeeAmount checks if an order's solver fee is within the acceptable
+// limits to be able to be filled by this solver (based on the routes min fee
+// bps). If it is not, the orders state will be set to abandoned in the db.
+func (r *orderFulfillmentHandler) checkFeeAmount(ctx context.Context, fillPolicy config.FillPolicy, orderFill db.Order) (bool, error) {
+	isWithinBpsRange, bpsDiff, err := IsWithinBpsRange(ctx, int64(fillPolicy.MinFeeBps), orderFill.AmountIn, orderFill.AmountOut)
 	if err != nil {
-		return false, fmt.Errorf("failed to set fill status to abandoned: %w", err)
+		return false, fmt.Errorf("checking if order fee for orderID %s is within min bps range: %w", orderFill.OrderID, err)
+	}
+	if isWithinBpsRange {
+		return true, nil
 	}
 
-	lmt.Logger(ctx).Info(
-		"abandoning transaction, "+abandonmentReason,
-		zap.String("orderID", orderFill.OrderID),
-		zap.String("sourceChainID", orderFill.SourceChainID),
-		zap.String("orderAmountIn", orderFill.AmountIn),
-		zap.String("minAllowedFillSize", destinationChainConfig.Cosmos.MinFillSize.String()),
-		zap.String("maxAllowedFillSize", destinationChainConfig.Cosmos.MaxFillSize.String()),
-	)
+	if err := r.abandonOrder(
+		ctx,
+		orderFill,
+		fmt.Sprintf("solver fee for order below configured min fee bps of %d", fillPolicy.MinFeeBps),
+		zap.String("orderAmountOut", orderFill.AmountOut),
+		zap.Int("minFeeBps", fillPolicy.MinFeeBps),
+	); err != nil {
+		return false, err
+	}
 
-	metrics.FromContext(ctx).IncFillOrderStatusChange(orderFill.SourceChainID, destinationChainConfig.ChainID, dbtypes.OrderStatusAbandoned)
-	metrics.FromContext(ctx).ObserveFillLatency(orderFill.SourceChainID, orderFill.DestinationChainID, dbtypes.OrderStatusAbandoned, time.Since(orderFill.CreatedAt))
-	metrics.FromContext(ctx).ObserveTransferSizeOutOfRange(
+	metrics.FromContext(ctx).ObserveFeeBpsRejection(
 		orderFill.SourceChainID,
-		destinationChainConfig.ChainID,
-		amountOutOfRange,
+		orderFill.DestinationChainID,
+		bpsDiff,
 	)
 	return false, nil
 }
 
-// checkFeeAmount checks if an order's solver fee is within the acceptable
-// limits to be able to be filled by this solver (based on the configured min
-// fee bps). If it is not, the orders state will be set to abandoned in the db.
-func (r *orderFulfillmentHandler) checkFeeAmount(ctx context.Context, orderFill db.Order) (bool, error) {
-	sourceChainID, err := config.GetConfigReader(ctx).GetChainConfig(orderFill.SourceChainID)
+// checkFillProfit checks if the expected net profit from filling an order,
+// after accounting for the fill tx cost and the orders share of the expected
+// settlement cost, is at least the configured min net fill profit for the
+// orders route. If the order would meet the min net fill profit without its
+// fill tx cost, it is only unprofitable because of current gas prices, so it
+// is left pending to be checked again in case gas prices fall. Otherwise the
+// orders state will be set to abandoned in the db.
+func (r *orderFulfillmentHandler) checkFillProfit(
+	ctx context.Context,
+	destinationChainBridgeClient cctp.BridgeClient,
+	destinationChainGatewayContractAddress string,
+	orderFill db.Order,
+) (bool, error) {
+	routeConfig, err := config.GetConfigReader(ctx).GetRouteConfig(orderFill.SourceChainID, orderFill.DestinationChainID)
 	if err != nil {
-		return false, fmt.Errorf("getting config for chainID %s: %w", orderFill.SourceChainID, err)
+		return false, fmt.Errorf("getting route config from chainID %s to %s: %w", orderFill.SourceChainID, orderFill.DestinationChainID, err)
+	}
+	minNetProfit := big.NewInt(0)
+	if routeConfig.MinNetFillProfitUUSDC != "" {
+		var ok bool
+		minNetProfit, ok = new(big.Int).SetString(routeConfig.MinNetFillProfitUUSDC, 10)
+		if !ok {
+			return false, fmt.Errorf("converting min net fill profit %s to *big.Int", routeConfig.MinNetFillProfitUUSDC)
+		}
 	}
 
-	isWithinBpsRange, bpsDiff, err := IsWithinBpsRange(ctx, int64(sourceChainID.MinFeeBps), orderFill.AmountIn, orderFill.AmountOut)
+	estimate, err := r.fillProfitEstimator.EstimateFillProfit(ctx, destinationChainBridgeClient, destinationChainGatewayContractAddress, orderFill)
 	if err != nil {
-		return false, fmt.Errorf("checking if order fee for orderID %s is within min bps range: %w", orderFill.OrderID, err)
+		return false, fmt.Errorf("estimating fill profit: %w", err)
 	}
-	if isWithinBpsRange {
+	if estimate.NetProfit.Cmp(minNetProfit) >= 0 {
 		return true, nil
 	}
 
+	if new(big.Int).Add(estimate.NetProfit, estimate.FillTxCost).Cmp(minNetProfit) >= 0 {
+		lmt.Logger(ctx).Info(
+			"deferring fill until fill tx cost falls",
+			zap.String("orderID", orderFill.OrderID),
+			zap.String("sourceChainID", orderFill.SourceChainID),
+			zap.String("destinationChainID", orderFill.DestinationChainID),
+			zap.String("fillTxCostUUSDC", estimate.FillTxCost.String()),
+			zap.String("netProfitUUSDC", estimate.NetProfit.String()),
+			zap.String("minNetFillProfitUUSDC", minNetProfit.String()),
+		)
+		return false, nil
+	}
+
+	abandonmentReason := fmt.Sprintf(
+		"expected net profit of %suusdc (solver fee %suusdc - fill tx cost %suusdc - settlement cost share %suusdc) is below configured min net fill profit of %suusdc",
+		estimate.NetProfit.String(),
+		estimate.SolverFee.String(),
+		estimate.FillTxCost.String(),
+		estimate.SettlementCostShare.String(),
+		minNetProfit.String(),
+	)
+	if err := r.abandonOrder(
+		ctx,
+		orderFill,
+		abandonmentReason,
+		zap.String("solverFeeUUSDC", estimate.SolverFee.String()),
+		zap.String("fillTxCostUUSDC", estimate.FillTxCost.String()),
+		zap.String("settlementCostShareUUSDC", estimate.SettlementCostShare.String()),
+		zap.String("netProfitUUSDC", estimate.NetProfit.String()),
+		zap.String("minNetFillProfitUUSDC", minNetProfit.String()),
+	); err != nil {
+		return false, err
+	}
+
+	metrics.FromContext(ctx).ObserveFillProfitRejection(
+		orderFill.SourceChainID,
+		orderFill.DestinationChainID,
+		new(big.Int).Sub(minNetProfit, estimate.NetProfit).Int64(),
+	)
+	return false, nil
+}
+
+// abandonOrder sets an orders state to abandoned in the db with reason as its
+// status message. fields are logged alongside the abandonment.
+func (r *orderFulfillmentHandler) abandonOrder(ctx context.Context, orderFill db.Order, reason string, fields ...zap.Field) error {
 	metrics.FromContext(ctx).IncFillOrderStatusChange(orderFill.SourceChainID, orderFill.DestinationChainID, dbtypes.OrderStatusAbandoned)
 	metrics.FromContext(ctx).ObserveFillLatency(orderFill.SourceChainID, orderFill.DestinationChainID, dbtypes.OrderStatusAbandoned, time.Since(orderFill.CreatedAt))
 
-	_, err = r.db.SetOrderStatus(ctx, db.SetOrderStatusParams{
+	if _, err := r.db.SetOrderStatus(ctx, db.SetOrderStatusParams{
 		SourceChainID:                     orderFill.SourceChainID,
 		OrderID:                           orderFill.OrderID,
 		SourceChainGatewayContractAddress: orderFill.SourceChainGatewayContractAddress,
 		OrderStatus:                       dbtypes.OrderStatusAbandoned,
-		OrderStatusMessage:                sql.NullString{String: fmt.Sprintf("solver fee for order below configured min fee bps of %d", sourceChainID.MinFeeBps), Valid: true},
-	})
-	if err != nil {
-		return false, fmt.Errorf("failed to set fill status to abandoned: %w", err)
+		OrderStatusMessage:                sql.NullString{String: reason, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to set fill status to abandoned: %w", err)
 	}
 
 	lmt.Logger(ctx).Info(
-		"abandoning transaction due to solver fee smaller than configured min fee bps",
-		zap.String("orderID", orderFill.OrderID),
-		zap.String("sourceChainID", orderFill.SourceChainID),
-		zap.String("orderAmountOut", orderFill.AmountOut),
-		zap.Int("minFeeBps", sourceChainID.MinFeeBps),
+		"abandoning transaction, "+reason,
+		append([]zap.Field{
+			zap.String("orderID", orderFill.OrderID),
+			zap.String("sourceChainID", orderFill.SourceChainID),
+			zap.String("destinationChainID", orderFill.DestinationChainID),
+		}, fields...)...,
 	)
-
-	metrics.FromContext(ctx).ObserveFeeBpsRejection(
-		orderFill.SourceChainID,
-		orderFill.DestinationChainID,
-		bpsDiff,
-	)
-	return false, nil
+	return nil
 }
 
 // IsWithinBpsRange returns true if the % change between amount in and amount
@@ -376,10 +630,10 @@ func IsWithinBpsRange(ctx context.Context, minFeeBps int64, amountIn, amountOut
 	return feeAmountScaled.Cmp(minAcceptableFeeScaled) >= 0, bpsDiff, nil
 }
 
-func (r *orderFulfillmentHandler) checkBlockConfirmations(ctx context.Context, sourceChainConfig config.ChainConfig, sourceChainBridgeClient cctp.BridgeClient, order db.Order) (confirmed bool, err error) {
+func (r *orderFulfillmentHandler) checkBlockConfirmations(ctx context.Context, fillPolicy config.FillPolicy, sourceChainBridgeClient cctp.BridgeClient, order db.Order) (confirmed bool, err error) {
 	if height, err := sourceChainBridgeClient.BlockHeight(ctx); err != nil {
 		return false, fmt.Errorf("failed to get block height: %w", err)
-	} else if uint64(order.OrderCreationTxBlockHeight+sourceChainConfig.NumBlockConfirmationsBeforeFill) > height {
+	} else if uint64(order.OrderCreationTxBlockHeight+fillPolicy.NumBlockConfirmationsBeforeFill) > height {
 		lmt.Logger(ctx).Debug("required block confirmations not met", zap.String("orderId", order.OrderID), zap.String("sourceChainID", order.SourceChainID))
 		return false, nil
 	} else {
@@ -388,19 +642,20 @@ func (r *orderFulfillmentHandler) checkBlockConfirmations(ctx context.Context, s
 			return false, err
 		}
 		if !exists {
-			metrics.FromContext(ctx).IncFillOrderStatusChange(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusAbandoned)
-			metrics.FromContext(ctx).ObserveFillLatency(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusAbandoned, time.Since(order.CreatedAt))
+			metrics.FromContext(ctx).IncFillOrderStatusChange(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusReorged)
+			metrics.FromContext(ctx).ObserveFillLatency(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusReorged, time.Since(order.CreatedAt))
 
 			if _, err := r.db.SetOrderStatus(ctx, db.SetOrderStatusParams{
 				SourceChainID:                     order.SourceChainID,
 				OrderID:                           order.OrderID,
 				SourceChainGatewayContractAddress: order.SourceChainGatewayContractAddress,
-				OrderStatus:                       dbtypes.OrderStatusAbandoned,
+				OrderStatus:                       dbtypes.OrderStatusReorged,
 				OrderStatusMessage:                sql.NullString{String: "reorged", Valid: true},
 			}); err != nil {
-				return false, fmt.Errorf("failed to set fill status to abandoned: %w", err)
+				return false, fmt.Errorf("failed to set fill status to reorged: %w", err)
 			}
-			lmt.Logger(ctx).Info("abandoning transaction due to reorg", zap.String("orderId", order.OrderID), zap.String("sourceChainID", order.SourceChainID))
+			lmt.Logger(ctx).Info("marking order as reorged", zap.String("orderId", order.OrderID), zap.String("sourceChainID", order.SourceChainID))
+			return false, nil
 		}
 		return true, nil
 	}
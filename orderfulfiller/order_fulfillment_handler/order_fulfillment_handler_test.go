@@ -2,9 +2,14 @@ package order_fulfillment_handler_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
+	"time"
 
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	handler "github.com/skip-mev/go-fast-solver/orderfulfiller/order_fulfillment_handler"
+	"github.com/skip-mev/go-fast-solver/shared/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -107,3 +112,133 @@ func Test_CheckFeeAmount(t *testing.T) {
 		})
 	}
 }
+
+func Test_SettlementCostShareUUSDC(t *testing.T) {
+	tests := []struct {
+		Name                        string
+		AmountIn                    int64
+		ExpectedSettlementCostUUSDC string
+		ExpectedRelayCostUUSDC      string
+		BatchUUSDCSettleUpThreshold string
+		ExpectedShare               int64
+	}{
+		{
+			Name:                        "no expected costs configured",
+			AmountIn:                    1000000,
+			BatchUUSDCSettleUpThreshold: "10000000",
+			ExpectedShare:               0,
+		},
+		{
+			Name:                        "order is a tenth of the batch",
+			AmountIn:                    1000000,
+			ExpectedSettlementCostUUSDC: "50000",
+			ExpectedRelayCostUUSDC:      "150000",
+			BatchUUSDCSettleUpThreshold: "10000000",
+			ExpectedShare:               20000,
+		},
+		{
+			Name:                        "share is rounded up",
+			AmountIn:                    1,
+			ExpectedRelayCostUUSDC:      "150000",
+			BatchUUSDCSettleUpThreshold: "10000000",
+			ExpectedShare:               1,
+		},
+		{
+			Name:                        "order larger than batch bears full cost",
+			AmountIn:                    20000000,
+			ExpectedSettlementCostUUSDC: "50000",
+			ExpectedRelayCostUUSDC:      "150000",
+			BatchUUSDCSettleUpThreshold: "10000000",
+			ExpectedShare:               200000,
+		},
+		{
+			Name:                        "no batch threshold bears full cost",
+			AmountIn:                    1000000,
+			ExpectedSettlementCostUUSDC: "50000",
+			ExpectedShare:               50000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			share, err := handler.SettlementCostShareUUSDC(
+				big.NewInt(tt.AmountIn),
+				config.RouteConfig{
+					ExpectedSettlementCostUUSDC: tt.ExpectedSettlementCostUUSDC,
+					ExpectedRelayCostUUSDC:      tt.ExpectedRelayCostUUSDC,
+				},
+				tt.BatchUUSDCSettleUpThreshold,
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpectedShare, share.Int64())
+		})
+	}
+}
+
+func Test_FillRetryPolicy(t *testing.T) {
+	now := time.Now()
+	policy := handler.FillRetryPolicy{MaxAttempts: 3, Backoff: time.Minute}
+	fillTx := func(id int64, status string, updatedAgo time.Duration) db.SubmittedTx {
+		return db.SubmittedTx{ID: id, TxStatus: status, UpdatedAt: now.Add(-updatedAgo)}
+	}
+
+	tests := []struct {
+		Name              string
+		FillTxs           []db.SubmittedTx
+		ShouldSubmit      bool
+		AttemptsExhausted bool
+	}{
+		{
+			Name:         "no previous attempts",
+			ShouldSubmit: true,
+		},
+		{
+			Name:    "previous attempt pending",
+			FillTxs: []db.SubmittedTx{fillTx(1, dbtypes.TxStatusPending, time.Hour)},
+		},
+		{
+			Name: "previous attempt pending after a failure",
+			FillTxs: []db.SubmittedTx{
+				fillTx(1, dbtypes.TxStatusFailed, time.Hour),
+				fillTx(2, dbtypes.TxStatusPending, time.Hour),
+			},
+		},
+		{
+			Name:    "previous attempt succeeded",
+			FillTxs: []db.SubmittedTx{fillTx(1, dbtypes.TxStatusSuccess, time.Hour)},
+		},
+		{
+			Name:         "failed attempt after backoff",
+			FillTxs:      []db.SubmittedTx{fillTx(1, dbtypes.TxStatusFailed, 2*time.Minute)},
+			ShouldSubmit: true,
+		},
+		{
+			Name:    "abandoned attempt within backoff",
+			FillTxs: []db.SubmittedTx{fillTx(1, dbtypes.TxStatusAbandoned, 30*time.Second)},
+		},
+		{
+			Name: "backoff doubles after each attempt",
+			FillTxs: []db.SubmittedTx{
+				fillTx(1, dbtypes.TxStatusFailed, time.Hour),
+				fillTx(2, dbtypes.TxStatusAbandoned, 90*time.Second),
+			},
+		},
+		{
+			Name: "attempts exhausted",
+			FillTxs: []db.SubmittedTx{
+				fillTx(1, dbtypes.TxStatusFailed, time.Hour),
+				fillTx(2, dbtypes.TxStatusFailed, time.Hour),
+				fillTx(3, dbtypes.TxStatusAbandoned, time.Hour),
+			},
+			AttemptsExhausted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			shouldSubmit, attemptsExhausted := policy.ShouldSubmitFill(tt.FillTxs, now)
+			assert.Equal(t, tt.ShouldSubmit, shouldSubmit)
+			assert.Equal(t, tt.AttemptsExhausted, attemptsExhausted)
+		})
+	}
+}
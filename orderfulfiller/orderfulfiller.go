@@ -16,10 +16,10 @@ import (
 )
 
 const (
-	requeueDelay                 = 30 * time.Second
-	orderQueueCapacity           = 100
-	pendingOrderDispatchInterval = 1 * time.Second
-	timeoutInterval              = 10 * time.Second
+	requeueDelay                        = 30 * time.Second
+	orderQueueCapacity                  = 100
+	defaultPendingOrderDispatchInterval = 15 * time.Second
+	timeoutInterval                     = 10 * time.Second
 )
 
 type OrderFulfillmentHandler interface {
@@ -34,23 +34,36 @@ type Database interface {
 	InTx(ctx context.Context, fn func(ctx context.Context, q db.Querier) error, opts *sql.TxOptions) error
 }
 
+// OrderSubscriber delivers new orders as soon as they are ingested
+type OrderSubscriber interface {
+	Subscribe(ctx context.Context) <-chan db.Order
+}
+
 type OrderFulfiller struct {
 	db                   Database
 	ordersQueue          *orderqueue.OrderQueue
+	newOrders            <-chan db.Order
+	dispatchInterval     time.Duration
 	fillHandler          OrderFulfillmentHandler
 	orderFillWorkerCount int
 	shouldFillOrders     bool
 	shouldRefundOrders   bool
 }
 
-func NewOrderFulfiller(ctx context.Context, db Database, orderFulfillmentWorkerCount int, orderFulfillmentHandler OrderFulfillmentHandler, shouldFillOrders, shouldRefundOrders bool) (*OrderFulfiller, error) {
+func NewOrderFulfiller(ctx context.Context, db Database, newOrders OrderSubscriber, orderFulfillmentWorkerCount int, pendingOrderPollInterval time.Duration, orderFulfillmentHandler OrderFulfillmentHandler, shouldFillOrders, shouldRefundOrders bool) (*OrderFulfiller, error) {
 	workerCount := orderFulfillmentWorkerCount
 	if workerCount <= 0 {
 		workerCount = 1
 	}
+	dispatchInterval := pendingOrderPollInterval
+	if dispatchInterval <= 0 {
+		dispatchInterval = defaultPendingOrderDispatchInterval
+	}
 	return &OrderFulfiller{
 		db:                   db,
-		ordersQueue:          orderqueue.NewOrderQueue(ctx, requeueDelay, orderQueueCapacity),
+		ordersQueue:          orderqueue.NewPriorityOrderQueue(ctx, requeueDelay, orderQueueCapacity, orderqueue.FeePerMinuteToExpiryScore),
+		newOrders:            newOrders.Subscribe(ctx),
+		dispatchInterval:     dispatchInterval,
 		fillHandler:          orderFulfillmentHandler,
 		orderFillWorkerCount: workerCount,
 		shouldFillOrders:     shouldFillOrders,
@@ -66,27 +79,47 @@ func (r *OrderFulfiller) Run(ctx context.Context) {
 	r.dispatchOrderFills(ctx)
 }
 
+// dispatchOrderFills queues new orders for filling as soon as they are
+// published, and periodically queues all pending orders from the db to pick up
+// orders whose fills were deferred or whose events were dropped
 func (r *OrderFulfiller) dispatchOrderFills(ctx context.Context) {
-	ticker := time.NewTicker(pendingOrderDispatchInterval)
+	r.queuePendingOrders(ctx)
+	ticker := time.NewTicker(r.dispatchInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
-			orders, err := r.db.GetAllOrdersWithOrderStatus(ctx, dbtypes.OrderStatusPending)
-			if err != nil {
-				lmt.Logger(ctx).Error("error getting pending orders", zap.Error(err))
-				continue
+		case order, ok := <-r.newOrders:
+			if !ok {
+				return
 			}
-			for _, order := range orders {
-				// we continuously try and push pending orders onto the queue
-				// so we don't need to check whether the order was successfully queued
-				_ = r.ordersQueue.QueueOrder(order)
+			if !r.ordersQueue.QueueOrder(order) {
+				lmt.Logger(ctx).Debug(
+					"could not queue new order, it will be queued by the pending order poll",
+					zap.String("orderID", order.OrderID),
+					zap.String("sourceChainID", order.SourceChainID),
+				)
 			}
+		case <-ticker.C:
+			r.queuePendingOrders(ctx)
 		}
 	}
 }
 
+func (r *OrderFulfiller) queuePendingOrders(ctx context.Context) {
+	orders, err := r.db.GetAllOrdersWithOrderStatus(ctx, dbtypes.OrderStatusPending)
+	if err != nil {
+		lmt.Logger(ctx).Error("error getting pending orders", zap.Error(err))
+		return
+	}
+	for _, order := range orders {
+		// we continuously try and push pending orders onto the queue
+		// so we don't need to check whether the order was successfully queued
+		_ = r.ordersQueue.QueueOrder(order)
+	}
+}
+
 func (r *OrderFulfiller) startOrderTimeoutWorker(ctx context.Context) {
 	ticker := time.NewTicker(timeoutInterval)
 	for {
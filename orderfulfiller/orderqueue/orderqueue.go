@@ -4,32 +4,54 @@
 package orderqueue
 
 import (
-	"github.com/skip-mev/go-fast-solver/db/gen/db"
-	"golang.org/x/net/context"
+	"math"
+	"math/big"
 	"sync"
 	"time"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"golang.org/x/net/context"
 )
 
 const (
 	cleanupInterval = 1 * time.Minute
 )
 
-// OrderQueue contains pending orders and fulfills them FIFO
+// ScoreFunc scores an order at the time now. Orders with higher scores are
+// popped from the queue first.
+type ScoreFunc func(order db.Order, now time.Time) float64
+
+// OrderQueue contains pending orders and fulfills them FIFO, or by highest
+// score if the queue was created with a ScoreFunc
 type OrderQueue struct {
 	orderRequeueTime map[int64]time.Time
 	ordersInQueue    map[int64]bool
-	orderChan        chan db.Order
-	requeueDelay     time.Duration
-	cleanupTicker    *time.Ticker
-	lock             sync.Mutex
-	stopCleanup      chan struct{}
+	// orders are kept in the order they were queued
+	orders []db.Order
+	score  ScoreFunc
+	// available holds one element per order in the queue, so that PopOrder
+	// can block until an order is queued
+	available     chan struct{}
+	requeueDelay  time.Duration
+	cleanupTicker *time.Ticker
+	lock          sync.Mutex
+	stopCleanup   chan struct{}
 }
 
 func NewOrderQueue(ctx context.Context, requeueDelay time.Duration, queueCapacity int) *OrderQueue {
+	return NewPriorityOrderQueue(ctx, requeueDelay, queueCapacity, nil)
+}
+
+// NewPriorityOrderQueue creates an order queue that pops the order with the
+// highest score first. Orders are scored when they are popped, so that scores
+// which depend on the current time (i.e. time until an order expires) are up
+// to date. If score is nil, orders are popped FIFO.
+func NewPriorityOrderQueue(ctx context.Context, requeueDelay time.Duration, queueCapacity int, score ScoreFunc) *OrderQueue {
 	orderQueue := &OrderQueue{
 		orderRequeueTime: make(map[int64]time.Time),
 		ordersInQueue:    make(map[int64]bool),
-		orderChan:        make(chan db.Order, queueCapacity),
+		score:            score,
+		available:        make(chan struct{}, queueCapacity),
 		requeueDelay:     requeueDelay,
 		cleanupTicker:    time.NewTicker(cleanupInterval),
 		stopCleanup:      make(chan struct{}),
@@ -47,29 +69,53 @@ func (d *OrderQueue) QueueOrder(order db.Order) bool {
 	if _, ok := d.orderRequeueTime[order.ID]; ok && time.Now().Before(d.orderRequeueTime[order.ID]) {
 		return false
 	}
-	select {
-	case d.orderChan <- order:
-		d.ordersInQueue[order.ID] = true
-		return true
-	default:
+	if len(d.orders) >= cap(d.available) {
 		return false
 	}
+
+	d.orders = append(d.orders, order)
+	d.ordersInQueue[order.ID] = true
+	d.available <- struct{}{}
+	return true
 }
 
 func (d *OrderQueue) PopOrder() <-chan db.Order {
 	out := make(chan db.Order)
 	go func() {
 		defer close(out)
-		order := <-d.orderChan
-		out <- order
+		<-d.available
 		d.lock.Lock()
+		order := d.popHighestScore()
 		d.orderRequeueTime[order.ID] = time.Now().Add(d.requeueDelay)
 		delete(d.ordersInQueue, order.ID)
 		d.lock.Unlock()
+		out <- order
 	}()
 	return out
 }
 
+// popHighestScore removes and returns the order with the highest score,
+// breaking ties by the order that was queued first. The lock must be held and
+// the queue must not be empty.
+func (d *OrderQueue) popHighestScore() db.Order {
+	best := 0
+	if d.score != nil {
+		now := time.Now()
+		bestScore := d.score(d.orders[0], now)
+		for i := 1; i < len(d.orders); i++ {
+			// only a strictly higher score replaces an order that was queued
+			// earlier
+			if score := d.score(d.orders[i], now); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+	}
+
+	order := d.orders[best]
+	d.orders = append(d.orders[:best], d.orders[best+1:]...)
+	return order
+}
+
 func (d *OrderQueue) startCleanup(ctx context.Context) {
 	for {
 		select {
@@ -87,3 +133,30 @@ func (d *OrderQueue) startCleanup(ctx context.Context) {
 		}
 	}
 }
+
+// FeePerMinuteToExpiryScore scores an order by its solver fee (amount in -
+// amount out) in uusdc divided by the number of minutes until the order times
+// out. This prioritizes orders with high fees and orders that are close to
+// expiring. Orders that have already expired can no longer be filled, so they
+// are scored lowest.
+func FeePerMinuteToExpiryScore(order db.Order, now time.Time) float64 {
+	if !order.TimeoutTimestamp.After(now) {
+		return math.Inf(-1)
+	}
+
+	amountIn, ok := new(big.Float).SetString(order.AmountIn)
+	if !ok {
+		return 0
+	}
+	amountOut, ok := new(big.Float).SetString(order.AmountOut)
+	if !ok {
+		return 0
+	}
+	fee, _ := new(big.Float).Sub(amountIn, amountOut).Float64()
+
+	minutesToExpiry := order.TimeoutTimestamp.Sub(now).Minutes()
+	if minutesToExpiry < 1 {
+		minutesToExpiry = 1
+	}
+	return fee / minutesToExpiry
+}
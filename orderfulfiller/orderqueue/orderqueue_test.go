@@ -0,0 +1,85 @@
+package orderqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func popOrder(t *testing.T, queue *OrderQueue) db.Order {
+	select {
+	case order := <-queue.PopOrder():
+		return order
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out popping order")
+		return db.Order{}
+	}
+}
+
+func TestOrderQueueFIFO(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queue := NewOrderQueue(ctx, time.Hour, 2)
+	assert.True(t, queue.QueueOrder(db.Order{ID: 1}))
+	assert.True(t, queue.QueueOrder(db.Order{ID: 2}))
+
+	// dedupes orders in the queue and respects capacity
+	assert.False(t, queue.QueueOrder(db.Order{ID: 1}))
+	assert.False(t, queue.QueueOrder(db.Order{ID: 3}))
+
+	assert.Equal(t, int64(1), popOrder(t, queue).ID)
+	assert.Equal(t, int64(2), popOrder(t, queue).ID)
+
+	// popped orders can not be requeued until the requeue delay has passed
+	assert.False(t, queue.QueueOrder(db.Order{ID: 1}))
+	assert.True(t, queue.QueueOrder(db.Order{ID: 3}))
+}
+
+func TestPriorityOrderQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	now := time.Now()
+	order := func(id int64, amountIn, amountOut string, timeout time.Duration) db.Order {
+		return db.Order{ID: id, AmountIn: amountIn, AmountOut: amountOut, TimeoutTimestamp: now.Add(timeout)}
+	}
+
+	queue := NewPriorityOrderQueue(ctx, time.Hour, 10, FeePerMinuteToExpiryScore)
+	assert.True(t, queue.QueueOrder(order(1, "1000000", "999000", time.Hour)))     // 1000uusdc fee, 1h to expiry
+	assert.True(t, queue.QueueOrder(order(2, "1000000", "900000", time.Hour)))     // 100000uusdc fee, 1h to expiry
+	assert.True(t, queue.QueueOrder(order(3, "1000000", "999000", 5*time.Minute))) // 1000uusdc fee, 5m to expiry
+	assert.True(t, queue.QueueOrder(order(4, "1000000", "999000", time.Hour)))     // same score as order 1
+	assert.True(t, queue.QueueOrder(order(5, "1000000", "900000", -time.Minute)))  // 100000uusdc fee, expired
+
+	assert.Equal(t, int64(2), popOrder(t, queue).ID)
+	assert.Equal(t, int64(3), popOrder(t, queue).ID)
+	assert.Equal(t, int64(1), popOrder(t, queue).ID)
+	assert.Equal(t, int64(4), popOrder(t, queue).ID)
+	assert.Equal(t, int64(5), popOrder(t, queue).ID)
+}
+
+func TestPopOrderBlocksUntilQueued(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	queue := NewOrderQueue(ctx, time.Hour, 1)
+	popped := queue.PopOrder()
+	select {
+	case <-popped:
+		require.FailNow(t, "popped order from empty queue")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	assert.True(t, queue.QueueOrder(db.Order{ID: 1}))
+	select {
+	case order := <-popped:
+		assert.Equal(t, int64(1), order.ID)
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out popping order")
+	}
+}
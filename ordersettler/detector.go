@@ -2,16 +2,19 @@ package ordersettler
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
 	"github.com/skip-mev/go-fast-solver/shared/contracts/fast_transfer_gateway"
 	"github.com/skip-mev/go-fast-solver/shared/lmt"
 	"go.uber.org/zap"
 
-	"github.com/skip-mev/go-fast-solver/shared/clientmanager"
 	"github.com/skip-mev/go-fast-solver/shared/config"
 )
 
@@ -23,108 +26,279 @@ type PendingSettlement struct {
 	Profit             *big.Int
 }
 
+// errFillNotSettleable is returned by detectPendingSettlement for order fills
+// that will never need to be settled by the solver
+var errFillNotSettleable = errors.New("order fill does not need to be settled")
+
+// ClientManager gets the bridge client for a chain
+type ClientManager interface {
+	GetClient(ctx context.Context, chainID string) (cctp.BridgeClient, error)
+}
+
+// SettlementLookup looks up order settlements that the solver is already
+// tracking
+type SettlementLookup interface {
+	GetOrderSettlement(ctx context.Context, arg db.GetOrderSettlementParams) (db.OrderSettlement, error)
+}
+
+// OrderFillLookup looks up orders that the solver has recorded as filled
+type OrderFillLookup interface {
+	GetOrderFillsByFillerPage(ctx context.Context, arg db.GetOrderFillsByFillerPageParams) ([]db.Order, error)
+}
+
 // DetectPendingSettlements scans all chains for pending settlements that need to be processed
 func DetectPendingSettlements(
 	ctx context.Context,
-	clientManager *clientmanager.ClientManager,
-	ordersSeen map[string]bool,
+	clientManager ClientManager,
+	orderFills OrderFillLookup,
 ) ([]PendingSettlement, error) {
 	var pendingSettlements []PendingSettlement
 
-	cosmosChains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(config.ChainType_COSMOS)
+	chains, err := settlementChains(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("error getting Cosmos chains: %w", err)
+		return nil, err
 	}
 
-	for _, chain := range cosmosChains {
+	for _, chain := range chains {
 		bridgeClient, err := clientManager.GetClient(ctx, chain.ChainID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get client: %w", err)
 		}
 
-		fills, err := bridgeClient.OrderFillsByFiller(ctx, chain.FastTransferContractAddress, chain.SolverAddress)
-		if err != nil {
-			return nil, fmt.Errorf("getting order fills: %w", err)
-		}
-
-		for _, fill := range fills {
-			if ordersSeen != nil && ordersSeen[fill.OrderID] {
-				continue
-			}
-
-			sourceChainID, err := config.GetConfigReader(ctx).GetChainIDByHyperlaneDomain(strconv.Itoa(int(fill.SourceDomain)))
+		var startAfter *string
+		for {
+			fills, err := orderFillsByFillerPage(ctx, orderFills, chain, bridgeClient, startAfter, orderFillScanPageSize)
 			if err != nil {
-				lmt.Logger(ctx).Warn(
-					"failed to get source chain ID by hyperlane domain. skipping order settlement. it may be unsettled.",
-					zap.Uint32("hyperlaneDomain", fill.SourceDomain),
-					zap.String("orderID", fill.OrderID),
-					zap.Error(err),
-				)
-				ordersSeen[fill.OrderID] = true
-				continue
+				return nil, fmt.Errorf("getting order fills: %w", err)
 			}
 
-			sourceGatewayAddress, err := config.GetConfigReader(ctx).GetGatewayContractAddress(sourceChainID)
-			if err != nil {
-				return nil, fmt.Errorf("getting source gateway address: %w", err)
+			for _, fill := range fills {
+				pendingSettlement, err := detectPendingSettlement(ctx, clientManager, nil, chain, bridgeClient, fill)
+				if errors.Is(err, errFillNotSettleable) {
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				if pendingSettlement != nil {
+					pendingSettlements = append(pendingSettlements, *pendingSettlement)
+				}
 			}
 
-			sourceBridgeClient, err := clientManager.GetClient(ctx, sourceChainID)
-			if err != nil {
-				return nil, fmt.Errorf("getting client for chainID %s: %w", sourceChainID, err)
+			if len(fills) < orderFillScanPageSize {
+				break
 			}
+			startAfter = &fills[len(fills)-1].OrderID
+		}
+	}
 
-			height, err := sourceBridgeClient.BlockHeight(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("fetching current block height on chain %s: %w", sourceChainID, err)
-			}
+	return pendingSettlements, nil
+}
 
-			// ensure order exists on source chain
-			exists, amount, err := sourceBridgeClient.OrderExists(ctx, sourceGatewayAddress, fill.OrderID, big.NewInt(int64(height)))
-			if err != nil {
-				return nil, fmt.Errorf("checking if order %s exists on chainID %s: %w", fill.OrderID, sourceChainID, err)
-			}
-			if !exists {
-				ordersSeen[fill.OrderID] = true
-				continue
-			}
+// settlementChains returns the configs of all cosmos and evm chains, which are
+// the chains that the solver fills orders on and initiates settlements from
+func settlementChains(ctx context.Context) ([]config.ChainConfig, error) {
+	var chains []config.ChainConfig
+	for _, chainType := range []config.ChainType{config.ChainType_COSMOS, config.ChainType_EVM} {
+		chainsOfType, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(chainType)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s chains: %w", chainType, err)
+		}
+		chains = append(chains, chainsOfType...)
+	}
+	return chains, nil
+}
 
-			// ensure order is not already filled (an order is only marked as
-			// filled on the source chain once it is settled)
-			status, err := sourceBridgeClient.OrderStatus(ctx, sourceGatewayAddress, fill.OrderID)
-			if err != nil {
-				return nil, fmt.Errorf("getting order %s status on chainID %s: %w", fill.OrderID, sourceChainID, err)
-			}
-			if status != fast_transfer_gateway.OrderStatusUnfilled {
-				ordersSeen[fill.OrderID] = true
-				continue
-			}
+// orderFillsByFillerPage returns up to limit of the solvers order fills on a
+// chain, ordered by order id and starting after the startAfter order id. Cosmos
+// gateways index fills by filler and are queried directly. The evm gateway does
+// not, so evm fills are read from the orders that the solver has recorded as
+// filled by it on the chain, and are confirmed against the gateway by
+// detectPendingSettlement before they are settled.
+func orderFillsByFillerPage(
+	ctx context.Context,
+	orderFills OrderFillLookup,
+	chain config.ChainConfig,
+	bridgeClient cctp.BridgeClient,
+	startAfter *string,
+	limit uint64,
+) ([]cctp.Fill, error) {
+	if chain.Type != config.ChainType_EVM {
+		return bridgeClient.OrderFillsByFillerPage(ctx, chain.FastTransferContractAddress, chain.SolverAddress, startAfter, limit)
+	}
 
-			orderFillEvent, _, err := bridgeClient.QueryOrderFillEvent(ctx, chain.FastTransferContractAddress, fill.OrderID)
-			if err != nil {
-				if _, ok := err.(cctp.ErrOrderFillEventNotFound); ok {
-					lmt.Logger(ctx).Warn(
-						"failed to find order fill event",
-						zap.String("fastTransferGatewayAddress", chain.FastTransferContractAddress),
-						zap.String("orderID", fill.OrderID),
-						zap.String("chainID", chain.ChainID),
-						zap.Error(err),
-					)
-					continue
-				}
-				return nil, fmt.Errorf("querying for order fill event on destination chain at address %s for order id %s: %w", chain.FastTransferContractAddress, fill.OrderID, err)
-			}
-			profit := new(big.Int).Sub(amount, orderFillEvent.FillAmount)
-
-			pendingSettlements = append(pendingSettlements, PendingSettlement{
-				SourceChainID:      sourceChainID,
-				DestinationChainID: chain.ChainID,
-				OrderID:            fill.OrderID,
-				Amount:             amount,
-				Profit:             profit,
-			})
+	var afterOrderID string
+	if startAfter != nil {
+		afterOrderID = *startAfter
+	}
+	orders, err := orderFills.GetOrderFillsByFillerPage(ctx, db.GetOrderFillsByFillerPageParams{
+		DestinationChainID: chain.ChainID,
+		Filler:             chain.SolverAddress,
+		OrderID:            afterOrderID,
+		Limit:              int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting orders filled by solver on chain %s: %w", chain.ChainID, err)
+	}
+
+	fills := make([]cctp.Fill, 0, len(orders))
+	for _, order := range orders {
+		fill, err := orderToFill(ctx, order)
+		if err != nil {
+			return nil, err
 		}
+		fills = append(fills, fill)
 	}
+	return fills, nil
+}
 
-	return pendingSettlements, nil
+// orderToFill converts an order that the solver has recorded as filled into
+// the fill of the order on its destination chain
+func orderToFill(ctx context.Context, order db.Order) (cctp.Fill, error) {
+	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
+	if err != nil {
+		return cctp.Fill{}, fmt.Errorf("getting source chain config for order %s: %w", order.OrderID, err)
+	}
+	sourceDomain, err := strconv.ParseUint(sourceChainConfig.HyperlaneDomain, 10, 32)
+	if err != nil {
+		return cctp.Fill{}, fmt.Errorf("parsing hyperlane domain %s of chainID %s: %w", sourceChainConfig.HyperlaneDomain, order.SourceChainID, err)
+	}
+	amountOut, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return cctp.Fill{}, fmt.Errorf("could not convert amount out %s of order %s to *big.Int", order.AmountOut, order.OrderID)
+	}
+
+	return cctp.Fill{
+		OrderID:      order.OrderID,
+		SourceDomain: uint32(sourceDomain),
+		AmountOut:    amountOut,
+	}, nil
+}
+
+// detectPendingSettlement returns the pending settlement for an order fill on
+// chain, or nil if the fill does not need to be settled yet. An error wrapping
+// errFillNotSettleable is returned if the fill will never need to be settled,
+// i.e. its order does not exist or is no longer unfilled on the source chain,
+// or it was filled by another solver. If settlements is not nil, fills that
+// already have a settlement tracked in it are skipped before querying the
+// source chain.
+func detectPendingSettlement(
+	ctx context.Context,
+	clientManager ClientManager,
+	settlements SettlementLookup,
+	chain config.ChainConfig,
+	bridgeClient cctp.BridgeClient,
+	fill cctp.Fill,
+) (*PendingSettlement, error) {
+	sourceChainID, err := config.GetConfigReader(ctx).GetChainIDByHyperlaneDomain(strconv.Itoa(int(fill.SourceDomain)))
+	if err != nil {
+		lmt.Logger(ctx).Warn(
+			"failed to get source chain ID by hyperlane domain. skipping order settlement. it may be unsettled.",
+			zap.Uint32("hyperlaneDomain", fill.SourceDomain),
+			zap.String("orderID", fill.OrderID),
+			zap.Error(err),
+		)
+		return nil, nil
+	}
+
+	sourceGatewayAddress, err := config.GetConfigReader(ctx).GetGatewayContractAddress(sourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting source gateway address: %w", err)
+	}
+
+	if settlements != nil {
+		_, err := settlements.GetOrderSettlement(ctx, db.GetOrderSettlementParams{
+			SourceChainID:                     sourceChainID,
+			SourceChainGatewayContractAddress: sourceGatewayAddress,
+			OrderID:                           fill.OrderID,
+		})
+		if err == nil {
+			return nil, nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("getting settlement for order %s from chainID %s: %w", fill.OrderID, sourceChainID, err)
+		}
+	}
+
+	sourceBridgeClient, err := clientManager.GetClient(ctx, sourceChainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting client for chainID %s: %w", sourceChainID, err)
+	}
+
+	height, err := sourceBridgeClient.BlockHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching current block height on chain %s: %w", sourceChainID, err)
+	}
+
+	// ensure order exists on source chain
+	exists, amount, err := sourceBridgeClient.OrderExists(ctx, sourceGatewayAddress, fill.OrderID, big.NewInt(int64(height)))
+	if err != nil {
+		return nil, fmt.Errorf("checking if order %s exists on chainID %s: %w", fill.OrderID, sourceChainID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: order does not exist on source chain %s", errFillNotSettleable, sourceChainID)
+	}
+
+	// ensure order is not already filled (an order is only marked as
+	// filled on the source chain once it is settled)
+	status, err := sourceBridgeClient.OrderStatus(ctx, sourceGatewayAddress, fill.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("getting order %s status on chainID %s: %w", fill.OrderID, sourceChainID, err)
+	}
+	if status != fast_transfer_gateway.OrderStatusUnfilled {
+		return nil, fmt.Errorf("%w: order has status %d on source chain %s", errFillNotSettleable, status, sourceChainID)
+	}
+
+	orderFillEvent, _, err := bridgeClient.QueryOrderFillEvent(ctx, chain.FastTransferContractAddress, fill.OrderID)
+	if err != nil {
+		if _, ok := err.(cctp.ErrOrderFillEventNotFound); ok {
+			lmt.Logger(ctx).Warn(
+				"failed to find order fill event",
+				zap.String("fastTransferGatewayAddress", chain.FastTransferContractAddress),
+				zap.String("orderID", fill.OrderID),
+				zap.String("chainID", chain.ChainID),
+				zap.Error(err),
+			)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("querying for order fill event on destination chain at address %s for order id %s: %w", chain.FastTransferContractAddress, fill.OrderID, err)
+	}
+	// fills read from the db may have since been reorged out of the chain, in
+	// which case the order may be filled again later
+	if orderFillEvent == nil {
+		lmt.Logger(ctx).Warn(
+			"order is not filled on chain. skipping order settlement.",
+			zap.String("fastTransferGatewayAddress", chain.FastTransferContractAddress),
+			zap.String("orderID", fill.OrderID),
+			zap.String("chainID", chain.ChainID),
+		)
+		return nil, nil
+	}
+	if !strings.EqualFold(orderFillEvent.Filler, chain.SolverAddress) {
+		lmt.Logger(ctx).Warn(
+			"order is not filled by the solver on chain. skipping order settlement.",
+			zap.String("fastTransferGatewayAddress", chain.FastTransferContractAddress),
+			zap.String("orderID", fill.OrderID),
+			zap.String("chainID", chain.ChainID),
+			zap.String("filler", orderFillEvent.Filler),
+		)
+		return nil, fmt.Errorf("%w: order was filled by %s", errFillNotSettleable, orderFillEvent.Filler)
+	}
+
+	// the evm gateway does not record fill amounts, so they are taken from
+	// the order that the solver filled
+	fillAmount := orderFillEvent.FillAmount
+	if fillAmount == nil {
+		fillAmount = fill.AmountOut
+	}
+	if fillAmount == nil {
+		return nil, fmt.Errorf("fill amount of order %s on chain %s is unknown", fill.OrderID, chain.ChainID)
+	}
+	profit := new(big.Int).Sub(amount, fillAmount)
+
+	return &PendingSettlement{
+		SourceChainID:      sourceChainID,
+		DestinationChainID: chain.ChainID,
+		OrderID:            fill.OrderID,
+		Amount:             amount,
+		Profit:             profit,
+	}, nil
 }
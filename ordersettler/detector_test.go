@@ -0,0 +1,325 @@
+package ordersettler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/connect"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/ordersettler/types"
+	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/contracts/fast_transfer_gateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEVMChainID        = "42161"
+	testEVMGateway        = "0x23cb6147e5600c23d1fb5543916d3d5457c9b54c"
+	testEVMSolverAddress  = "0x8ba1f109551bd432803012645ac136ddd64dba72"
+	testCosmosChainID     = "osmosis-1"
+	testCosmosGateway     = "osmo1gateway"
+	testCosmosSolver      = "osmo1solver"
+	testCosmosHyperlaneID = "875"
+)
+
+type fakeClientManager map[string]cctp.BridgeClient
+
+func (m fakeClientManager) GetClient(ctx context.Context, chainID string) (cctp.BridgeClient, error) {
+	client, ok := m[chainID]
+	if !ok {
+		return nil, fmt.Errorf("no client for chain %s", chainID)
+	}
+	return client, nil
+}
+
+// fakeBridgeClient implements the parts of a bridge client used by the order
+// settler. Calling any other method panics.
+type fakeBridgeClient struct {
+	cctp.BridgeClient
+
+	// orderAmounts are the amounts of the orders submitted to this chains
+	// gateway, by order id
+	orderAmounts map[string]*big.Int
+
+	// fillers are the fillers of the orders filled on this chains gateway, by
+	// order id
+	fillers map[string]string
+
+	// fills are the fills returned from this chains gateway fill index
+	fills []cctp.Fill
+
+	// orderExistsCalls counts the order exists queries made to this chain
+	orderExistsCalls int
+
+	settlementTxHash string
+}
+
+func (c *fakeBridgeClient) BlockHeight(ctx context.Context) (uint64, error) {
+	return 100, nil
+}
+
+func (c *fakeBridgeClient) OrderExists(ctx context.Context, gatewayContractAddress, orderID string, blockNumber *big.Int) (bool, *big.Int, error) {
+	c.orderExistsCalls++
+	amount, ok := c.orderAmounts[orderID]
+	return ok, amount, nil
+}
+
+func (c *fakeBridgeClient) OrderStatus(ctx context.Context, gatewayContractAddress, orderID string) (uint8, error) {
+	return fast_transfer_gateway.OrderStatusUnfilled, nil
+}
+
+func (c *fakeBridgeClient) QueryOrderFillEvent(ctx context.Context, gatewayContractAddress, orderID string) (*cctp.OrderFillEvent, time.Time, error) {
+	filler, ok := c.fillers[orderID]
+	if !ok {
+		return nil, time.Now(), nil
+	}
+	return &cctp.OrderFillEvent{Filler: filler}, time.Now(), nil
+}
+
+func (c *fakeBridgeClient) OrderFillsByFillerPage(ctx context.Context, gatewayContractAddress, fillerAddress string, startAfter *string, limit uint64) ([]cctp.Fill, error) {
+	var page []cctp.Fill
+	for _, fill := range c.fills {
+		if startAfter != nil && fill.OrderID <= *startAfter {
+			continue
+		}
+		if uint64(len(page)) == limit {
+			break
+		}
+		page = append(page, fill)
+	}
+	return page, nil
+}
+
+func (c *fakeBridgeClient) InitiateBatchSettlement(ctx context.Context, batch types.SettlementBatch) (string, string, error) {
+	return c.settlementTxHash, "raw" + c.settlementTxHash, nil
+}
+
+func newTestDB(t *testing.T) *db.Queries {
+	conn, err := connect.ConnectAndMigrate(context.Background(), filepath.Join(t.TempDir(), "solver.db"), "../db/migrations")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return db.New(conn)
+}
+
+func testConfigContext() context.Context {
+	return config.ConfigReaderContext(context.Background(), config.NewConfigReader(config.Config{
+		Chains: map[string]config.ChainConfig{
+			"osmosis": {
+				ChainID:                     testCosmosChainID,
+				Type:                        config.ChainType_COSMOS,
+				HyperlaneDomain:             testCosmosHyperlaneID,
+				FastTransferContractAddress: testCosmosGateway,
+				SolverAddress:               testCosmosSolver,
+				BatchUUSDCSettleUpThreshold: "1000",
+			},
+			"arbitrum": {
+				ChainID:                     testEVMChainID,
+				Type:                        config.ChainType_EVM,
+				HyperlaneDomain:             testEVMChainID,
+				FastTransferContractAddress: testEVMGateway,
+				SolverAddress:               testEVMSolverAddress,
+				BatchUUSDCSettleUpThreshold: "1000",
+			},
+		},
+	}))
+}
+
+// insertFilledOrder inserts an order from osmosis to the evm chain that has
+// been recorded as filled by filler
+func insertFilledOrder(t *testing.T, ctx context.Context, database *db.Queries, orderID, amountIn, amountOut, filler string) {
+	_, err := database.InsertOrder(ctx, db.InsertOrderParams{
+		SourceChainID:                     testCosmosChainID,
+		DestinationChainID:                testEVMChainID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+		Sender:                            []byte("sender"),
+		Recipient:                         []byte("recipient"),
+		AmountIn:                          amountIn,
+		AmountOut:                         amountOut,
+		OrderCreationTx:                   "tx" + orderID,
+		OrderID:                           orderID,
+		OrderStatus:                       dbtypes.OrderStatusPending,
+		TimeoutTimestamp:                  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = database.SetFillTx(ctx, db.SetFillTxParams{
+		Filler:                            sql.NullString{String: filler, Valid: true},
+		OrderStatus:                       dbtypes.OrderStatusFilled,
+		SourceChainID:                     testCosmosChainID,
+		OrderID:                           orderID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+	})
+	require.NoError(t, err)
+}
+
+func Test_OrderSettler_SettlesEVMFills(t *testing.T) {
+	ctx := testConfigContext()
+	database := newTestDB(t)
+
+	// fillers recorded from the evm gateway are checksummed
+	checksummedSolverAddress := "0x8ba1f109551bD432803012645Ac136ddd64DBA72"
+	insertFilledOrder(t, ctx, database, "aa", "1000", "990", checksummedSolverAddress)
+	insertFilledOrder(t, ctx, database, "bb", "500", "490", "0x0000000000000000000000000000000000000001")
+	// recorded as filled by the solver, but no longer filled on chain
+	insertFilledOrder(t, ctx, database, "cc", "700", "690", checksummedSolverAddress)
+
+	clientManager := fakeClientManager{
+		testCosmosChainID: &fakeBridgeClient{
+			orderAmounts: map[string]*big.Int{"aa": big.NewInt(1000), "bb": big.NewInt(500), "cc": big.NewInt(700)},
+		},
+		testEVMChainID: &fakeBridgeClient{
+			fillers: map[string]string{
+				"aa": checksummedSolverAddress,
+				"bb": "0x0000000000000000000000000000000000000001",
+			},
+			settlementTxHash: "0xsettlement",
+		},
+	}
+
+	settler, err := NewOrderSettler(ctx, database, clientManager, nil)
+	require.NoError(t, err)
+	require.NoError(t, settler.createPendingSettlements(ctx))
+
+	pendingSettlements, err := DetectPendingSettlements(ctx, clientManager, database)
+	require.NoError(t, err)
+	assert.Equal(t, []PendingSettlement{{
+		SourceChainID:      testCosmosChainID,
+		DestinationChainID: testEVMChainID,
+		OrderID:            "aa",
+		Amount:             big.NewInt(1000),
+		Profit:             big.NewInt(10),
+	}}, pendingSettlements)
+
+	batches, err := settler.PendingSettlementBatches(ctx)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	batch := batches[0]
+	assert.Equal(t, []string{"aa"}, batch.OrderIDs())
+	assert.Equal(t, testCosmosChainID, batch.SourceChainID())
+	assert.Equal(t, testEVMChainID, batch.DestinationChainID())
+	assert.Equal(t, "1000", batch[0].Amount)
+	assert.Equal(t, "10", batch[0].Profit)
+
+	trigger, err := settler.SettlementTrigger(ctx, batch)
+	require.NoError(t, err)
+	assert.Equal(t, dbtypes.SettlementTriggerValueThreshold, trigger)
+	batch.SetTrigger(trigger)
+
+	hash, err := settler.SettleBatch(ctx, batch)
+	require.NoError(t, err)
+	assert.Equal(t, "0xsettlement", hash)
+
+	settlement, err := database.GetOrderSettlement(ctx, db.GetOrderSettlementParams{
+		SourceChainID:                     testCosmosChainID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+		OrderID:                           "aa",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullString{String: "0xsettlement", Valid: true}, settlement.InitiateSettlementTx)
+	require.True(t, settlement.SettlementBatchID.Valid)
+
+	settlementBatch, err := database.GetSettlementBatch(ctx, settlement.SettlementBatchID.Int64)
+	require.NoError(t, err)
+	assert.Equal(t, testEVMChainID, settlementBatch.DestinationChainID)
+	assert.Equal(t, int64(1), settlementBatch.NumOrders)
+	assert.Equal(t, dbtypes.SettlementTriggerValueThreshold, settlementBatch.SettlementTrigger)
+	assert.Equal(t, dbtypes.SettlementStatusPending, settlementBatch.SettlementStatus)
+}
+
+func Test_OrderSettler_ScanOrderFills(t *testing.T) {
+	ctx := testConfigContext()
+	database := newTestDB(t)
+
+	// orders from osmosis filled by the solver on the evm chain, made up of one
+	// fill that needs to be settled, one whose fill event is not yet found on
+	// chain and enough fills whose orders do not exist on the source chain to
+	// span more than one scan
+	insertFilledOrder(t, ctx, database, "settle", "1000", "990", testEVMSolverAddress)
+	insertFilledOrder(t, ctx, database, "unfound", "1000", "990", testEVMSolverAddress)
+	for i := 0; i < orderFillScanPageSize*maxOrderFillScanPages; i++ {
+		insertFilledOrder(t, ctx, database, fmt.Sprintf("missing%04d", i), "1000", "990", testEVMSolverAddress)
+	}
+	// orders filled by other solvers are never scanned
+	insertFilledOrder(t, ctx, database, "other", "1000", "990", "0xother")
+
+	cosmosClient := &fakeBridgeClient{orderAmounts: map[string]*big.Int{
+		"settle":  big.NewInt(1000),
+		"unfound": big.NewInt(1000),
+		"0000":    big.NewInt(1000),
+	}}
+	clientManager := fakeClientManager{
+		testCosmosChainID: cosmosClient,
+		testEVMChainID: &fakeBridgeClient{fillers: map[string]string{
+			"settle": testEVMSolverAddress,
+			"0000":   testEVMSolverAddress,
+		}},
+	}
+
+	settler, err := NewOrderSettler(ctx, database, clientManager, nil)
+	require.NoError(t, err)
+	chain, err := config.GetConfigReader(ctx).GetChainConfig(testEVMChainID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		// newFill is the id of an order filled before the scan
+		newFill                  string
+		expectedOrderExistsCalls int
+	}{
+		{
+			name:                     "first scan stops after max pages",
+			expectedOrderExistsCalls: orderFillScanPageSize * maxOrderFillScanPages,
+		},
+		{
+			name:                     "second scan checks the remaining and unfound fills",
+			expectedOrderExistsCalls: 3,
+		},
+		{
+			name:                     "third scan only checks the unfound fill",
+			expectedOrderExistsCalls: 1,
+		},
+		{
+			name:                     "new fills are scanned on the next scan",
+			newFill:                  "0000",
+			expectedOrderExistsCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.newFill != "" {
+				insertFilledOrder(t, ctx, database, tt.newFill, "1000", "990", testEVMSolverAddress)
+			}
+
+			cosmosClient.orderExistsCalls = 0
+			require.NoError(t, settler.scanOrderFills(ctx, chain))
+			assert.Equal(t, tt.expectedOrderExistsCalls, cosmosClient.orderExistsCalls)
+		})
+	}
+
+	for _, orderID := range []string{"settle", "0000"} {
+		settlement, err := database.GetOrderSettlement(ctx, db.GetOrderSettlementParams{
+			SourceChainID:                     testCosmosChainID,
+			SourceChainGatewayContractAddress: testCosmosGateway,
+			OrderID:                           orderID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, dbtypes.SettlementStatusPending, settlement.SettlementStatus)
+		assert.Equal(t, "10", settlement.Profit)
+	}
+
+	unsettleable, err := database.GetUnsettleableOrderFill(ctx, db.GetUnsettleableOrderFillParams{
+		ChainID:                testEVMChainID,
+		GatewayContractAddress: testEVMGateway,
+		OrderID:                "missing0000",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, unsettleable.Reason, "order does not exist on source chain")
+}
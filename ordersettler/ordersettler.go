@@ -16,8 +16,6 @@ import (
 	"github.com/skip-mev/go-fast-solver/shared/metrics"
 	"golang.org/x/sync/errgroup"
 
-	"github.com/skip-mev/go-fast-solver/shared/clientmanager"
-
 	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	"github.com/skip-mev/go-fast-solver/shared/config"
 	"github.com/skip-mev/go-fast-solver/shared/lmt"
@@ -34,21 +32,52 @@ var params = Config{
 
 const (
 	excessiveSettlementLatency = 1 * time.Hour
+
+	// maxInitiateSettlementRetries is the number of times a settlement whose
+	// initiate settlement tx failed is returned to pending to be settled in a
+	// new batch, before it is marked as failed
+	maxInitiateSettlementRetries = 3
+
+	// orderFillScanPageSize is the number of order fills queried at a time
+	// when scanning a gateway for fills that need to be settled
+	orderFillScanPageSize = 100
+
+	// maxOrderFillScanPages is the maximum number of pages of unchecked order
+	// fills scanned per gateway each interval. Any remaining fills are scanned
+	// on the next interval.
+	maxOrderFillScanPages = 10
 )
 
 type Database interface {
 	GetAllOrderSettlementsWithSettlementStatus(ctx context.Context, settlementStatus string) ([]db.OrderSettlement, error)
+	GetOrderSettlement(ctx context.Context, arg db.GetOrderSettlementParams) (db.OrderSettlement, error)
 
 	SetSettlementStatus(ctx context.Context, arg db.SetSettlementStatusParams) (db.OrderSettlement, error)
 
 	SetInitiateSettlementTx(ctx context.Context, arg db.SetInitiateSettlementTxParams) (db.OrderSettlement, error)
+	GetOrderSettlementsWithFailedInitiateSettlementTx(ctx context.Context) ([]db.OrderSettlement, error)
 	SetCompleteSettlementTx(ctx context.Context, arg db.SetCompleteSettlementTxParams) (db.OrderSettlement, error)
 
 	InsertSubmittedTx(ctx context.Context, arg db.InsertSubmittedTxParams) (db.SubmittedTx, error)
 
 	InsertOrderSettlement(ctx context.Context, arg db.InsertOrderSettlementParams) (db.OrderSettlement, error)
+	GetOrderSettlementsBySettlementBatchID(ctx context.Context, settlementBatchID sql.NullInt64) ([]db.OrderSettlement, error)
 	SetOrderStatus(ctx context.Context, arg db.SetOrderStatusParams) (db.Order, error)
 
+	InsertSettlementBatch(ctx context.Context, arg db.InsertSettlementBatchParams) (db.SettlementBatch, error)
+	GetAllSettlementBatchesWithSettlementStatus(ctx context.Context, settlementStatus string) ([]db.SettlementBatch, error)
+	SetSettlementBatchStatus(ctx context.Context, arg db.SetSettlementBatchStatusParams) (db.SettlementBatch, error)
+	SetSettlementBatchInitiated(ctx context.Context, arg db.SetSettlementBatchInitiatedParams) (db.SettlementBatch, error)
+	SetSettlementBatchCompleted(ctx context.Context, arg db.SetSettlementBatchCompletedParams) (db.SettlementBatch, error)
+	SetSettlementBatchRelaySubmitted(ctx context.Context, arg db.SetSettlementBatchRelaySubmittedParams) error
+	SetSettlementBatchRelayDelivered(ctx context.Context, arg db.SetSettlementBatchRelayDeliveredParams) (db.SettlementBatch, error)
+
+	GetHyperlaneTransferByMessageSentTx(ctx context.Context, arg db.GetHyperlaneTransferByMessageSentTxParams) (db.HyperlaneTransfer, error)
+	GetSubmittedTxsByHyperlaneTransferId(ctx context.Context, hyperlaneTransferID sql.NullInt64) ([]db.SubmittedTx, error)
+
+	GetUnprocessedOrderFillsPage(ctx context.Context, arg db.GetUnprocessedOrderFillsPageParams) ([]db.Order, error)
+	InsertUnsettleableOrderFill(ctx context.Context, arg db.InsertUnsettleableOrderFillParams) error
+
 	InTx(ctx context.Context, fn func(ctx context.Context, q db.Querier) error, opts *sql.TxOptions) error
 }
 
@@ -58,22 +87,20 @@ type Relayer interface {
 
 type OrderSettler struct {
 	db            Database
-	clientManager *clientmanager.ClientManager
+	clientManager ClientManager
 	relayer       Relayer
-	ordersSeen    map[string]bool
 }
 
 func NewOrderSettler(
 	ctx context.Context,
 	db Database,
-	clientManager *clientmanager.ClientManager,
+	clientManager ClientManager,
 	relayer Relayer,
 ) (*OrderSettler, error) {
 	return &OrderSettler{
 		db:            db,
 		clientManager: clientManager,
 		relayer:       relayer,
-		ordersSeen:    make(map[string]bool),
 	}, nil
 }
 
@@ -95,6 +122,10 @@ func (r *OrderSettler) Run(ctx context.Context) {
 			continue
 		}
 
+		if err := r.recoverFailedSettlements(ctx); err != nil {
+			lmt.Logger(ctx).Error("error recovering failed settlements", zap.Error(err))
+		}
+
 		if err := r.settleOrders(ctx); err != nil {
 			lmt.Logger(ctx).Error("error settling orders", zap.Error(err))
 		}
@@ -102,41 +133,182 @@ func (r *OrderSettler) Run(ctx context.Context) {
 		if err := r.verifyOrderSettlements(ctx); err != nil {
 			lmt.Logger(ctx).Error("error verifying settlements", zap.Error(err))
 		}
+
+		if err := r.verifySettlementBatches(ctx); err != nil {
+			lmt.Logger(ctx).Error("error verifying settlement batches", zap.Error(err))
+		}
 	}
 }
 
+// createPendingSettlements scans the solvers order fills on each cosmos and evm
+// chain and inserts a pending settlement for each fill that needs to be
+// settled.
 func (r *OrderSettler) createPendingSettlements(ctx context.Context) error {
-	pendingSettlements, err := DetectPendingSettlements(ctx, r.clientManager, r.ordersSeen)
+	chains, err := settlementChains(ctx)
 	if err != nil {
-		return fmt.Errorf("detecting pending settlements: %w", err)
+		return err
 	}
 
-	for _, settlement := range pendingSettlements {
-		sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(settlement.SourceChainID)
-		if err != nil {
-			return fmt.Errorf("getting source chain config: %w", err)
+	for _, chain := range chains {
+		if err := r.scanOrderFills(ctx, chain); err != nil {
+			return fmt.Errorf("scanning order fills on chain %s: %w", chain.ChainID, err)
 		}
+	}
 
-		_, err = r.db.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
-			SourceChainID:                     settlement.SourceChainID,
-			DestinationChainID:                settlement.DestinationChainID,
-			SourceChainGatewayContractAddress: sourceChainConfig.FastTransferContractAddress,
-			OrderID:                           settlement.OrderID,
-			SettlementStatus:                  dbtypes.SettlementStatusPending,
-			Amount:                            settlement.Amount.String(),
-			Profit:                            settlement.Profit.String(),
+	return nil
+}
+
+// scanOrderFills scans the orders that the solver has recorded as filled by it
+// on a chain and that are not yet tracked as a settlement or recorded as
+// unsettleable, and inserts a pending settlement for each fill that needs to
+// be settled. Fills that will never need to be settled are recorded as
+// unsettleable so that the source chain is only queried for them once. Since
+// checked fills are excluded by the query, each scan only reads new fills and
+// fills that are not yet settleable, such as fills with no fill event found on
+// chain, up to maxOrderFillScanPages pages of them.
+func (r *OrderSettler) scanOrderFills(ctx context.Context, chain config.ChainConfig) error {
+	bridgeClient, err := r.clientManager.GetClient(ctx, chain.ChainID)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	var afterID int64
+	for page := 0; page < maxOrderFillScanPages; page++ {
+		orders, err := r.db.GetUnprocessedOrderFillsPage(ctx, db.GetUnprocessedOrderFillsPageParams{
+			GatewayContractAddress: chain.FastTransferContractAddress,
+			DestinationChainID:     chain.ChainID,
+			Filler:                 chain.SolverAddress,
+			ID:                     afterID,
+			Limit:                  orderFillScanPageSize,
 		})
+		if err != nil {
+			return fmt.Errorf("getting unprocessed order fills: %w", err)
+		}
+
+		for _, order := range orders {
+			fill, err := orderToFill(ctx, order)
+			if err != nil {
+				return err
+			}
 
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("failed to insert settlement: %w", err)
+			pendingSettlement, err := detectPendingSettlement(ctx, r.clientManager, r.db, chain, bridgeClient, fill)
+			if errors.Is(err, errFillNotSettleable) {
+				if err := r.db.InsertUnsettleableOrderFill(ctx, db.InsertUnsettleableOrderFillParams{
+					ChainID:                chain.ChainID,
+					GatewayContractAddress: chain.FastTransferContractAddress,
+					OrderID:                fill.OrderID,
+					Reason:                 err.Error(),
+				}); err != nil {
+					return fmt.Errorf("inserting unsettleable order fill %s: %w", fill.OrderID, err)
+				}
+				continue
+			} else if err != nil {
+				return fmt.Errorf("detecting pending settlement for order %s: %w", fill.OrderID, err)
+			}
+			if pendingSettlement == nil {
+				continue
+			}
+			if err := r.createPendingSettlement(ctx, *pendingSettlement); err != nil {
+				return err
+			}
+		}
+
+		if len(orders) < orderFillScanPageSize {
+			break
 		}
-		r.ordersSeen[settlement.OrderID] = true
-		metrics.FromContext(ctx).IncOrderSettlementStatusChange(settlement.SourceChainID, settlement.DestinationChainID, dbtypes.SettlementStatusPending)
+		afterID = orders[len(orders)-1].ID
+	}
+
+	return nil
+}
+
+func (r *OrderSettler) createPendingSettlement(ctx context.Context, settlement PendingSettlement) error {
+	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(settlement.SourceChainID)
+	if err != nil {
+		return fmt.Errorf("getting source chain config: %w", err)
+	}
+
+	_, err = r.db.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
+		SourceChainID:                     settlement.SourceChainID,
+		DestinationChainID:                settlement.DestinationChainID,
+		SourceChainGatewayContractAddress: sourceChainConfig.FastTransferContractAddress,
+		OrderID:                           settlement.OrderID,
+		SettlementStatus:                  dbtypes.SettlementStatusPending,
+		Amount:                            settlement.Amount.String(),
+		Profit:                            settlement.Profit.String(),
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to insert settlement: %w", err)
 	}
+	metrics.FromContext(ctx).IncOrderSettlementStatusChange(settlement.SourceChainID, settlement.DestinationChainID, dbtypes.SettlementStatusPending)
 
 	return nil
 }
 
+// recoverFailedSettlements finds settlements whose initiate settlement tx was
+// marked as failed or abandoned by the tx verifier, and returns them to pending
+// so that they are settled again in a new batch. Settlements that have already
+// been retried maxInitiateSettlementRetries times are marked as failed instead.
+func (r *OrderSettler) recoverFailedSettlements(ctx context.Context) error {
+	settlements, err := r.db.GetOrderSettlementsWithFailedInitiateSettlementTx(ctx)
+	if err != nil {
+		return fmt.Errorf("getting settlements with failed initiate settlement txs: %w", err)
+	}
+	if len(settlements) == 0 {
+		return nil
+	}
+
+	return r.db.InTx(ctx, func(ctx context.Context, q db.Querier) error {
+		failedBatches := make(map[int64]string)
+		for _, settlement := range settlements {
+			status := dbtypes.SettlementStatusPending
+			message := fmt.Sprintf("initiate settlement tx %s failed, retrying settlement", settlement.InitiateSettlementTx.String)
+			if settlement.InitiateSettlementFailures >= maxInitiateSettlementRetries {
+				status = dbtypes.SettlementStatusFailed
+				message = fmt.Sprintf(
+					"initiate settlement tx %s failed after %d retries, not retrying settlement",
+					settlement.InitiateSettlementTx.String,
+					settlement.InitiateSettlementFailures,
+				)
+			}
+
+			if _, err := q.ClearInitiateSettlementTx(ctx, db.ClearInitiateSettlementTxParams{
+				SettlementStatus:                  status,
+				SettlementStatusMessage:           sql.NullString{String: message, Valid: true},
+				SourceChainID:                     settlement.SourceChainID,
+				OrderID:                           settlement.OrderID,
+				SourceChainGatewayContractAddress: settlement.SourceChainGatewayContractAddress,
+			}); err != nil {
+				return fmt.Errorf("clearing initiate settlement tx for settlement from source chain %s with order id %s: %w", settlement.SourceChainID, settlement.OrderID, err)
+			}
+			if settlement.SettlementBatchID.Valid {
+				failedBatches[settlement.SettlementBatchID.Int64] = settlement.InitiateSettlementTx.String
+			}
+
+			metrics.FromContext(ctx).IncOrderSettlementStatusChange(settlement.SourceChainID, settlement.DestinationChainID, status)
+			lmt.Logger(ctx).Warn(
+				"initiate settlement tx failed",
+				zap.String("orderID", settlement.OrderID),
+				zap.String("sourceChainID", settlement.SourceChainID),
+				zap.String("initiateSettlementTx", settlement.InitiateSettlementTx.String),
+				zap.Int64("previousFailures", settlement.InitiateSettlementFailures),
+				zap.String("settlementStatus", status),
+			)
+		}
+
+		for batchID, txHash := range failedBatches {
+			if _, err := q.SetSettlementBatchStatus(ctx, db.SetSettlementBatchStatusParams{
+				SettlementStatus:        dbtypes.SettlementStatusFailed,
+				SettlementStatusMessage: sql.NullString{String: fmt.Sprintf("initiate settlement tx %s failed", txHash), Valid: true},
+				ID:                      batchID,
+			}); err != nil {
+				return fmt.Errorf("setting settlement batch %d status to failed: %w", batchID, err)
+			}
+		}
+		return nil
+	}, nil)
+}
+
 // settleOrders gets pending settlements out of the db and initiates a
 // settlement on the settlements destination chain gateway contract, updating
 // the settlements status in the db.
@@ -148,19 +320,47 @@ func (r *OrderSettler) settleOrders(ctx context.Context) error {
 
 	var toSettle []types.SettlementBatch
 	for _, batch := range batches {
-		shouldSettle, err := r.ShouldInitiateSettlement(ctx, batch)
+		routeConfig, err := config.GetConfigReader(ctx).GetRouteConfig(batch.SourceChainID(), batch.DestinationChainID())
 		if err != nil {
-			return fmt.Errorf("checking if order settlement should be initiated for batch from source chain %s to destination chain %s: %w", batch.SourceChainID(), batch.DestinationChainID(), err)
+			return fmt.Errorf("getting route config from chainID %s to chainID %s: %w", batch.SourceChainID(), batch.DestinationChainID(), err)
 		}
-		if !shouldSettle {
-			lmt.Logger(ctx).Debug(
-				"settlement batch is not ready for settlement yet",
-				zap.String("sourceChainID", batch.SourceChainID()),
-				zap.String("destinationChainID", batch.DestinationChainID()),
-			)
-			continue
+
+		// batches are split before checking their settlement triggers so that
+		// each settlement that is initiated meets a trigger on its own. orders
+		// left over in a split batch that does not are settled in a later
+		// batch.
+		subBatches := []types.SettlementBatch{batch}
+		if routeConfig.MaxOrdersPerSettlementBatch != nil {
+			subBatches = batch.Split(*routeConfig.MaxOrdersPerSettlementBatch)
+			if len(subBatches) > 1 {
+				lmt.Logger(ctx).Info(
+					"splitting settlement batch that exceeds max orders per settlement batch",
+					zap.String("sourceChainID", batch.SourceChainID()),
+					zap.String("destinationChainID", batch.DestinationChainID()),
+					zap.Int("numOrders", len(batch)),
+					zap.Int("maxOrdersPerSettlementBatch", *routeConfig.MaxOrdersPerSettlementBatch),
+					zap.Int("numBatches", len(subBatches)),
+				)
+			}
+		}
+
+		for _, subBatch := range subBatches {
+			trigger, err := r.SettlementTrigger(ctx, subBatch)
+			if err != nil {
+				return fmt.Errorf("checking if order settlement should be initiated for batch from source chain %s to destination chain %s: %w", subBatch.SourceChainID(), subBatch.DestinationChainID(), err)
+			}
+			if trigger == "" {
+				lmt.Logger(ctx).Debug(
+					"settlement batch is not ready for settlement yet",
+					zap.String("sourceChainID", subBatch.SourceChainID()),
+					zap.String("destinationChainID", subBatch.DestinationChainID()),
+					zap.Int("numOrders", len(subBatch)),
+				)
+				continue
+			}
+			subBatch.SetTrigger(trigger)
+			toSettle = append(toSettle, subBatch)
 		}
-		toSettle = append(toSettle, batch)
 	}
 
 	if len(toSettle) == 0 {
@@ -237,13 +437,28 @@ func (r *OrderSettler) relayBatch(
 		)
 	}
 
-	return r.relaySettlement(
+	if err := r.relaySettlement(
 		ctx,
 		txHash,
 		settlementInitiationChainID,
 		settlementPayoutChainID,
 		maxTxFeeUUSDC,
-	)
+	); err != nil {
+		return err
+	}
+
+	// settlements initiated before settlement batches were recorded do not
+	// have a batch
+	if !batch[0].SettlementBatchID.Valid {
+		return nil
+	}
+	if err := r.db.SetSettlementBatchRelaySubmitted(ctx, db.SetSettlementBatchRelaySubmittedParams{
+		MaxRelayTxFeeUusdc: sql.NullString{String: maxTxFeeUUSDC.String(), Valid: true},
+		ID:                 batch[0].SettlementBatchID.Int64,
+	}); err != nil {
+		return fmt.Errorf("recording relay submission for settlement batch %d: %w", batch[0].SettlementBatchID.Int64, err)
+	}
+	return nil
 }
 
 // relaySettlement submits a tx hash for a settlement to be relayed with
@@ -348,53 +563,120 @@ func (r *OrderSettler) PendingSettlementBatches(ctx context.Context) ([]types.Se
 	return types.IntoSettlementBatchesByChains(uniniatedSettlements), nil
 }
 
-// ShouldInitiateSettlement returns true if a settlement should be initiated
-// for a batch based on the uusdc settle up threshold set in the order settler
-// config.
-func (r *OrderSettler) ShouldInitiateSettlement(ctx context.Context, batch types.SettlementBatch) (bool, error) {
+// SettlementTrigger returns which of the settlement triggers configured for
+// a batches route has fired, or an empty string if the batch should not be
+// settled yet. Batches are settled once their value reaches the source chains
+// uusdc settle up threshold, or once any of the routes optional max age, max
+// batch count or capital need triggers fire.
+func (r *OrderSettler) SettlementTrigger(ctx context.Context, batch types.SettlementBatch) (string, error) {
 	value, err := batch.TotalValue()
 	if err != nil {
-		return false, fmt.Errorf("getting settlement batch total value: %w", err)
+		return "", fmt.Errorf("getting settlement batch total value: %w", err)
 	}
 
 	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(batch.SourceChainID())
 	if err != nil {
-		return false, fmt.Errorf("getting source chain config for chainID %s: %w", batch.SourceChainID(), err)
+		return "", fmt.Errorf("getting source chain config for chainID %s: %w", batch.SourceChainID(), err)
 	}
 	settlementThreshold, ok := new(big.Int).SetString(sourceChainConfig.BatchUUSDCSettleUpThreshold, 10)
 	if !ok {
-		return false, fmt.Errorf(
-			"could not convert batch uusdc settle up threshold %s for chainID %s to *big.Int: %w",
+		return "", fmt.Errorf(
+			"could not convert batch uusdc settle up threshold %s for chainID %s to *big.Int",
 			sourceChainConfig.BatchUUSDCSettleUpThreshold,
 			batch.SourceChainID(),
-			err,
 		)
 	}
+	if value.Cmp(settlementThreshold) >= 0 {
+		return dbtypes.SettlementTriggerValueThreshold, nil
+	}
 
-	return value.Cmp(settlementThreshold) >= 0, nil
+	routeConfig, err := config.GetConfigReader(ctx).GetRouteConfig(batch.SourceChainID(), batch.DestinationChainID())
+	if err != nil {
+		return "", fmt.Errorf("getting route config from chainID %s to chainID %s: %w", batch.SourceChainID(), batch.DestinationChainID(), err)
+	}
+	if routeConfig.SettlementMaxBatchCount != nil && len(batch) >= *routeConfig.SettlementMaxBatchCount {
+		return dbtypes.SettlementTriggerMaxBatchCount, nil
+	}
+	if routeConfig.SettlementMaxAge != nil && time.Since(batch.OldestCreatedAt()) >= *routeConfig.SettlementMaxAge {
+		return dbtypes.SettlementTriggerMaxAge, nil
+	}
+	if routeConfig.SettleOnCapitalNeed {
+		needsCapital, err := r.sourceChainNeedsCapital(ctx, sourceChainConfig)
+		if err != nil {
+			return "", fmt.Errorf("checking if source chain %s needs capital: %w", batch.SourceChainID(), err)
+		}
+		if needsCapital {
+			return dbtypes.SettlementTriggerCapitalNeed, nil
+		}
+	}
+
+	return "", nil
+}
+
+// sourceChainNeedsCapital returns true if the solvers uusdc balance on a chain
+// is below the chains fund rebalancer min allowed amount. Chains without a
+// fund rebalancer config never need capital.
+func (r *OrderSettler) sourceChainNeedsCapital(ctx context.Context, chainConfig config.ChainConfig) (bool, error) {
+	fundRebalancerConfig, err := config.GetConfigReader(ctx).GetFundRebalancingConfig(chainConfig.ChainID)
+	if err != nil {
+		lmt.Logger(ctx).Debug(
+			"settle on capital need is enabled but chain has no fund rebalancer config",
+			zap.String("chainID", chainConfig.ChainID),
+		)
+		return false, nil
+	}
+	minAllowedAmount, ok := new(big.Int).SetString(fundRebalancerConfig.MinAllowedAmount, 10)
+	if !ok {
+		return false, fmt.Errorf("could not convert min allowed amount %s for chainID %s to *big.Int", fundRebalancerConfig.MinAllowedAmount, chainConfig.ChainID)
+	}
+
+	client, err := r.clientManager.GetClient(ctx, chainConfig.ChainID)
+	if err != nil {
+		return false, fmt.Errorf("getting client for chainID %s: %w", chainConfig.ChainID, err)
+	}
+	balance, err := client.Balance(ctx, chainConfig.SolverAddress, chainConfig.USDCDenom)
+	if err != nil {
+		return false, fmt.Errorf("getting solver uusdc balance on chainID %s: %w", chainConfig.ChainID, err)
+	}
+
+	return balance.Cmp(minAllowedAmount) < 0, nil
 }
 
 // SettleBatches tries to settle a list settlement batches and update the
 // individual settlements status's, returning the tx hash for each initiated
-// settlement, in the same order as batches.
+// settlement, in the same order as batches. Batches initiated on the same
+// chain are submitted one at a time, and a batch that fails to be initiated
+// does not stop batches on other chains from being initiated.
 func (r *OrderSettler) SettleBatches(ctx context.Context, batches []types.SettlementBatch) ([]string, error) {
-	g, gCtx := errgroup.WithContext(ctx)
+	var g errgroup.Group
 	hashes := make([]string, len(batches))
 	hashesLock := new(sync.Mutex)
 
+	// indexes of the batches initiated on each chain (the batches destination
+	// chain), in the order they are submitted
+	batchesByChain := make(map[string][]int)
+	var chainIDs []string
 	for i, batch := range batches {
-		i := i
-		batch := batch
+		if _, ok := batchesByChain[batch.DestinationChainID()]; !ok {
+			chainIDs = append(chainIDs, batch.DestinationChainID())
+		}
+		batchesByChain[batch.DestinationChainID()] = append(batchesByChain[batch.DestinationChainID()], i)
+	}
+
+	for _, chainID := range chainIDs {
+		indexes := batchesByChain[chainID]
 		g.Go(func() error {
-			hash, err := r.SettleBatch(gCtx, batch)
-			if err != nil {
-				return fmt.Errorf("settling batch from source chain %s to destination chain %s: %w", batch.SourceChainID(), batch.DestinationChainID(), err)
+			for _, i := range indexes {
+				batch := batches[i]
+				hash, err := r.SettleBatch(ctx, batch)
+				if err != nil {
+					return fmt.Errorf("settling batch from source chain %s to destination chain %s: %w", batch.SourceChainID(), batch.DestinationChainID(), err)
+				}
+
+				hashesLock.Lock()
+				hashes[i] = hash
+				hashesLock.Unlock()
 			}
-
-			hashesLock.Lock()
-			defer hashesLock.Unlock()
-			hashes[i] = hash
-
 			return nil
 		})
 	}
@@ -426,7 +708,30 @@ func (r *OrderSettler) SettleBatch(ctx context.Context, batch types.SettlementBa
 		return "", fmt.Errorf("empty batch settlement transaction")
 	}
 
+	totalValue, err := batch.TotalValue()
+	if err != nil {
+		return "", fmt.Errorf("calculating total value for batch: %w", err)
+	}
+	totalProfit, err := batch.TotalProfit()
+	if err != nil {
+		return "", fmt.Errorf("calculating total profit for batch: %w", err)
+	}
+
 	err = r.db.InTx(ctx, func(ctx context.Context, q db.Querier) error {
+		settlementBatch, err := q.InsertSettlementBatch(ctx, db.InsertSettlementBatchParams{
+			SourceChainID:        batch.SourceChainID(),
+			DestinationChainID:   batch.DestinationChainID(),
+			InitiateSettlementTx: txHash,
+			SettlementTrigger:    batch.Trigger(),
+			NumOrders:            int64(len(batch)),
+			TotalValue:           totalValue.String(),
+			TotalProfit:          totalProfit.String(),
+			SettlementStatus:     dbtypes.SettlementStatusPending,
+		})
+		if err != nil {
+			return fmt.Errorf("inserting settlement batch for settlement with hash %s: %w", txHash, err)
+		}
+
 		// First update all settlements with the initiate settlement tx
 		for _, settlement := range batch {
 			settlementTx := db.SetInitiateSettlementTxParams{
@@ -434,6 +739,8 @@ func (r *OrderSettler) SettleBatch(ctx context.Context, batch types.SettlementBa
 				OrderID:                           settlement.OrderID,
 				SourceChainGatewayContractAddress: settlement.SourceChainGatewayContractAddress,
 				InitiateSettlementTx:              sql.NullString{String: txHash, Valid: true},
+				SettlementTrigger:                 settlement.SettlementTrigger,
+				SettlementBatchID:                 sql.NullInt64{Int64: settlementBatch.ID, Valid: true},
 			}
 			if _, err = q.SetInitiateSettlementTx(ctx, settlementTx); err != nil {
 				return fmt.Errorf("setting initiate settlement tx for settlement from source chain %s with order id %s: %w", settlement.SourceChainID, settlement.OrderID, err)
@@ -542,6 +849,140 @@ func (r *OrderSettler) verifyOrderSettlement(ctx context.Context, settlement db.
 	return fmt.Errorf("settlement is not complete")
 }
 
+// verifySettlementBatches updates the status of all incomplete settlement
+// batches from the status of the order settlements in each batch, and records
+// when each batches settlement is delivered by hyperlane.
+func (r *OrderSettler) verifySettlementBatches(ctx context.Context) error {
+	var incompleteBatches []db.SettlementBatch
+	for _, status := range []string{dbtypes.SettlementStatusPending, dbtypes.SettlementStatusSettlementInitiated} {
+		batches, err := r.db.GetAllSettlementBatchesWithSettlementStatus(ctx, status)
+		if err != nil {
+			return fmt.Errorf("getting settlement batches with status %s: %w", status, err)
+		}
+		incompleteBatches = append(incompleteBatches, batches...)
+	}
+
+	for _, batch := range incompleteBatches {
+		if err := r.verifySettlementBatch(ctx, batch); err != nil {
+			lmt.Logger(ctx).Warn(
+				"failed to verify settlement batch, will retry verification on next interval",
+				zap.Error(err),
+				zap.Int64("settlementBatchID", batch.ID),
+				zap.String("initiateSettlementTx", batch.InitiateSettlementTx),
+			)
+		}
+	}
+
+	return nil
+}
+
+// verifySettlementBatch moves a settlement batch through its lifecycle based
+// on the status of its order settlements. A batch is initiated once none of its
+// settlements are pending, complete once all of its settlements are complete,
+// and failed if any of its settlements failed.
+func (r *OrderSettler) verifySettlementBatch(ctx context.Context, batch db.SettlementBatch) error {
+	settlements, err := r.db.GetOrderSettlementsBySettlementBatchID(ctx, sql.NullInt64{Int64: batch.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("getting order settlements for settlement batch: %w", err)
+	}
+	if len(settlements) == 0 {
+		return fmt.Errorf("settlement batch has no order settlements")
+	}
+
+	var numPending, numComplete int
+	for _, settlement := range settlements {
+		switch settlement.SettlementStatus {
+		case dbtypes.SettlementStatusFailed:
+			if _, err := r.db.SetSettlementBatchStatus(ctx, db.SetSettlementBatchStatusParams{
+				SettlementStatus:        dbtypes.SettlementStatusFailed,
+				SettlementStatusMessage: settlement.SettlementStatusMessage,
+				ID:                      batch.ID,
+			}); err != nil {
+				return fmt.Errorf("setting settlement batch status to failed: %w", err)
+			}
+			return nil
+		case dbtypes.SettlementStatusPending:
+			numPending++
+		case dbtypes.SettlementStatusComplete:
+			numComplete++
+		}
+	}
+
+	if batch.SettlementStatus == dbtypes.SettlementStatusPending && numPending == 0 {
+		if batch, err = r.db.SetSettlementBatchInitiated(ctx, db.SetSettlementBatchInitiatedParams{
+			SettlementStatus: dbtypes.SettlementStatusSettlementInitiated,
+			ID:               batch.ID,
+		}); err != nil {
+			return fmt.Errorf("setting settlement batch status to initiated: %w", err)
+		}
+	}
+
+	if batch.RelaySubmittedAt.Valid && !batch.RelayDeliveredAt.Valid {
+		if err := r.recordSettlementBatchRelayDelivery(ctx, batch); err != nil {
+			return fmt.Errorf("recording settlement batch relay delivery: %w", err)
+		}
+	}
+
+	if numComplete == len(settlements) {
+		if _, err := r.db.SetSettlementBatchCompleted(ctx, db.SetSettlementBatchCompletedParams{
+			SettlementStatus: dbtypes.SettlementStatusComplete,
+			ID:               batch.ID,
+		}); err != nil {
+			return fmt.Errorf("setting settlement batch status to complete: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recordSettlementBatchRelayDelivery records when the hyperlane message for a
+// settlement batch was delivered and the total cost of the delivery txs the
+// solver submitted for it. Nothing is recorded until the message has been
+// delivered and all delivery txs have landed.
+func (r *OrderSettler) recordSettlementBatchRelayDelivery(ctx context.Context, batch db.SettlementBatch) error {
+	// the settlement message is dispatched on the orders destination chain
+	transfer, err := r.db.GetHyperlaneTransferByMessageSentTx(ctx, db.GetHyperlaneTransferByMessageSentTxParams{
+		MessageSentTx: batch.InitiateSettlementTx,
+		SourceChainID: batch.DestinationChainID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("getting hyperlane transfer for tx %s: %w", batch.InitiateSettlementTx, err)
+	}
+	if transfer.TransferStatus != dbtypes.TransferStatusSuccess {
+		return nil
+	}
+
+	txs, err := r.db.GetSubmittedTxsByHyperlaneTransferId(ctx, sql.NullInt64{Int64: transfer.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("getting submitted txs for hyperlane transfer %d: %w", transfer.ID, err)
+	}
+	relayCost := big.NewInt(0)
+	for _, tx := range txs {
+		if tx.TxStatus == dbtypes.TxStatusPending {
+			return nil
+		}
+		if !tx.TxCostUusdc.Valid {
+			continue
+		}
+		cost, ok := new(big.Int).SetString(tx.TxCostUusdc.String, 10)
+		if !ok {
+			return fmt.Errorf("converting tx cost %s of tx %s to *big.Int", tx.TxCostUusdc.String, tx.TxHash)
+		}
+		relayCost.Add(relayCost, cost)
+	}
+
+	if _, err := r.db.SetSettlementBatchRelayDelivered(ctx, db.SetSettlementBatchRelayDeliveredParams{
+		RelayCostUusdc:   sql.NullString{String: relayCost.String(), Valid: true},
+		RelayDeliveredAt: sql.NullTime{Time: transfer.UpdatedAt, Valid: true},
+		ID:               batch.ID,
+	}); err != nil {
+		return fmt.Errorf("setting settlement batch relay delivery: %w", err)
+	}
+	return nil
+}
+
 func (r *OrderSettler) IncompleteSettlements(ctx context.Context) ([]db.OrderSettlement, error) {
 	pendingSettlements, err := r.db.GetAllOrderSettlementsWithSettlementStatus(ctx, dbtypes.SettlementStatusPending)
 	if err != nil {
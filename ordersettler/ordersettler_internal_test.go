@@ -0,0 +1,420 @@
+package ordersettler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertInitiatedSettlement inserts a settlement of an order from osmosis to
+// the evm chain that has previously failed to be initiated priorFailures
+// times, and whose latest initiate settlement tx has txStatus
+func insertInitiatedSettlement(t *testing.T, ctx context.Context, database *db.Queries, orderID string, priorFailures int, txStatus string) db.SettlementBatch {
+	settlement, err := database.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
+		SourceChainID:                     testCosmosChainID,
+		DestinationChainID:                testEVMChainID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+		Amount:                            "1000",
+		Profit:                            "10",
+		OrderID:                           orderID,
+		SettlementStatus:                  dbtypes.SettlementStatusPending,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < priorFailures; i++ {
+		_, err := database.ClearInitiateSettlementTx(ctx, db.ClearInitiateSettlementTxParams{
+			SettlementStatus:                  dbtypes.SettlementStatusPending,
+			SourceChainID:                     testCosmosChainID,
+			OrderID:                           orderID,
+			SourceChainGatewayContractAddress: testCosmosGateway,
+		})
+		require.NoError(t, err)
+	}
+
+	txHash := "0x" + orderID
+	batch, err := database.InsertSettlementBatch(ctx, db.InsertSettlementBatchParams{
+		SourceChainID:        testCosmosChainID,
+		DestinationChainID:   testEVMChainID,
+		InitiateSettlementTx: txHash,
+		SettlementTrigger:    dbtypes.SettlementTriggerValueThreshold,
+		NumOrders:            1,
+		TotalValue:           "1000",
+		TotalProfit:          "10",
+		SettlementStatus:     dbtypes.SettlementStatusSettlementInitiated,
+	})
+	require.NoError(t, err)
+
+	_, err = database.SetInitiateSettlementTx(ctx, db.SetInitiateSettlementTxParams{
+		InitiateSettlementTx:              sql.NullString{String: txHash, Valid: true},
+		SettlementTrigger:                 sql.NullString{String: dbtypes.SettlementTriggerValueThreshold, Valid: true},
+		SettlementBatchID:                 sql.NullInt64{Int64: batch.ID, Valid: true},
+		SourceChainID:                     testCosmosChainID,
+		OrderID:                           orderID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+	})
+	require.NoError(t, err)
+
+	_, err = database.InsertSubmittedTx(ctx, db.InsertSubmittedTxParams{
+		OrderSettlementID: sql.NullInt64{Int64: settlement.ID, Valid: true},
+		ChainID:           testEVMChainID,
+		TxHash:            txHash,
+		RawTx:             "raw" + txHash,
+		TxType:            dbtypes.TxTypeSettlement,
+		TxStatus:          txStatus,
+	})
+	require.NoError(t, err)
+
+	return batch
+}
+
+func Test_OrderSettler_RecoverFailedSettlements(t *testing.T) {
+	tests := []struct {
+		name             string
+		priorFailures    int
+		txStatus         string
+		expectedStatus   string
+		expectedFailures int64
+		expectedMessage  string
+	}{
+		{
+			name:             "failed tx is retried",
+			txStatus:         dbtypes.TxStatusFailed,
+			expectedStatus:   dbtypes.SettlementStatusPending,
+			expectedFailures: 1,
+			expectedMessage:  "initiate settlement tx 0xaa failed, retrying settlement",
+		},
+		{
+			name:             "abandoned tx is retried",
+			priorFailures:    maxInitiateSettlementRetries - 1,
+			txStatus:         dbtypes.TxStatusAbandoned,
+			expectedStatus:   dbtypes.SettlementStatusPending,
+			expectedFailures: maxInitiateSettlementRetries,
+			expectedMessage:  "initiate settlement tx 0xaa failed, retrying settlement",
+		},
+		{
+			name:             "settlement fails once retries are exhausted",
+			priorFailures:    maxInitiateSettlementRetries,
+			txStatus:         dbtypes.TxStatusFailed,
+			expectedStatus:   dbtypes.SettlementStatusFailed,
+			expectedFailures: maxInitiateSettlementRetries + 1,
+			expectedMessage:  "initiate settlement tx 0xaa failed after 3 retries, not retrying settlement",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testConfigContext()
+			database := newTestDB(t)
+			batch := insertInitiatedSettlement(t, ctx, database, "aa", tt.priorFailures, tt.txStatus)
+
+			settler, err := NewOrderSettler(ctx, database, fakeClientManager{}, nil)
+			require.NoError(t, err)
+			require.NoError(t, settler.recoverFailedSettlements(ctx))
+
+			settlementParams := db.GetOrderSettlementParams{
+				SourceChainID:                     testCosmosChainID,
+				SourceChainGatewayContractAddress: testCosmosGateway,
+				OrderID:                           "aa",
+			}
+			settlement, err := database.GetOrderSettlement(ctx, settlementParams)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, settlement.SettlementStatus)
+			assert.Equal(t, sql.NullString{String: tt.expectedMessage, Valid: true}, settlement.SettlementStatusMessage)
+			assert.Equal(t, tt.expectedFailures, settlement.InitiateSettlementFailures)
+			assert.False(t, settlement.InitiateSettlementTx.Valid)
+			assert.False(t, settlement.SettlementBatchID.Valid)
+			assert.False(t, settlement.SettlementTrigger.Valid)
+
+			settlementBatch, err := database.GetSettlementBatch(ctx, batch.ID)
+			require.NoError(t, err)
+			assert.Equal(t, dbtypes.SettlementStatusFailed, settlementBatch.SettlementStatus)
+			assert.Equal(t, sql.NullString{String: "initiate settlement tx 0xaa failed", Valid: true}, settlementBatch.SettlementStatusMessage)
+
+			// the cleared settlement no longer references the failed tx, so
+			// it is not recovered again
+			failed, err := database.GetOrderSettlementsWithFailedInitiateSettlementTx(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, failed)
+
+			require.NoError(t, settler.recoverFailedSettlements(ctx))
+			settlement, err = database.GetOrderSettlement(ctx, settlementParams)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFailures, settlement.InitiateSettlementFailures)
+		})
+	}
+}
+
+// relayTx is a hyperlane message delivery tx submitted for a settlement
+// batches settlement message
+type relayTx struct {
+	status    string
+	costUUSDC string
+}
+
+// settleTestBatch initiates a settlement of a batch of two orders from osmosis
+// to the evm chain with tx hash 0xsettlement and returns the batch
+func settleTestBatch(t *testing.T, ctx context.Context, database *db.Queries, settler *OrderSettler) db.SettlementBatch {
+	for _, orderID := range []string{"aa", "bb"} {
+		_, err := database.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
+			SourceChainID:                     testCosmosChainID,
+			DestinationChainID:                testEVMChainID,
+			SourceChainGatewayContractAddress: testCosmosGateway,
+			Amount:                            "1000",
+			Profit:                            "10",
+			OrderID:                           orderID,
+			SettlementStatus:                  dbtypes.SettlementStatusPending,
+		})
+		require.NoError(t, err)
+	}
+
+	batches, err := settler.PendingSettlementBatches(ctx)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	batches[0].SetTrigger(dbtypes.SettlementTriggerValueThreshold)
+	hash, err := settler.SettleBatch(ctx, batches[0])
+	require.NoError(t, err)
+
+	settlement, err := database.GetOrderSettlement(ctx, db.GetOrderSettlementParams{
+		SourceChainID:                     testCosmosChainID,
+		SourceChainGatewayContractAddress: testCosmosGateway,
+		OrderID:                           "aa",
+	})
+	require.NoError(t, err)
+	require.True(t, settlement.SettlementBatchID.Valid)
+
+	batch, err := database.GetSettlementBatch(ctx, settlement.SettlementBatchID.Int64)
+	require.NoError(t, err)
+	require.Equal(t, hash, batch.InitiateSettlementTx)
+	return batch
+}
+
+func Test_OrderSettler_VerifySettlementBatch(t *testing.T) {
+	tests := []struct {
+		name                   string
+		settlementStatuses     [2]string
+		transferStatus         string
+		relayTxs               []relayTx
+		expectedStatus         string
+		expectedStatusMessage  sql.NullString
+		expectInitiated        bool
+		expectCompleted        bool
+		expectedRelayCostUUSDC sql.NullString
+	}{
+		{
+			name:               "pending settlements keep the batch pending",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusPending},
+			expectedStatus:     dbtypes.SettlementStatusPending,
+		},
+		{
+			name:               "initiated settlements initiate the batch",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusSettlementInitiated},
+			expectedStatus:     dbtypes.SettlementStatusSettlementInitiated,
+			expectInitiated:    true,
+		},
+		{
+			name:               "partially complete batch stays initiated",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusComplete, dbtypes.SettlementStatusSettlementInitiated},
+			expectedStatus:     dbtypes.SettlementStatusSettlementInitiated,
+			expectInitiated:    true,
+		},
+		{
+			name:               "complete settlements complete the batch",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusComplete, dbtypes.SettlementStatusComplete},
+			expectedStatus:     dbtypes.SettlementStatusComplete,
+			expectInitiated:    true,
+			expectCompleted:    true,
+		},
+		{
+			name:                  "failed settlement fails the batch",
+			settlementStatuses:    [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusFailed},
+			expectedStatus:        dbtypes.SettlementStatusFailed,
+			expectedStatusMessage: sql.NullString{String: "settlement tx reverted", Valid: true},
+		},
+		{
+			name:               "delivered relay records the delivery cost",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusSettlementInitiated},
+			transferStatus:     dbtypes.TransferStatusSuccess,
+			relayTxs: []relayTx{
+				{status: dbtypes.TxStatusFailed, costUUSDC: "20"},
+				{status: dbtypes.TxStatusSuccess, costUUSDC: "30"},
+			},
+			expectedStatus:         dbtypes.SettlementStatusSettlementInitiated,
+			expectInitiated:        true,
+			expectedRelayCostUUSDC: sql.NullString{String: "50", Valid: true},
+		},
+		{
+			name:               "pending relay tx delays recording the delivery",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusSettlementInitiated},
+			transferStatus:     dbtypes.TransferStatusSuccess,
+			relayTxs: []relayTx{
+				{status: dbtypes.TxStatusFailed, costUUSDC: "20"},
+				{status: dbtypes.TxStatusPending},
+			},
+			expectedStatus:  dbtypes.SettlementStatusSettlementInitiated,
+			expectInitiated: true,
+		},
+		{
+			name:               "undelivered relay is not recorded",
+			settlementStatuses: [2]string{dbtypes.SettlementStatusSettlementInitiated, dbtypes.SettlementStatusSettlementInitiated},
+			transferStatus:     dbtypes.TransferStatusPending,
+			expectedStatus:     dbtypes.SettlementStatusSettlementInitiated,
+			expectInitiated:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testConfigContext()
+			database := newTestDB(t)
+			settler, err := NewOrderSettler(ctx, database, fakeClientManager{
+				testEVMChainID: &fakeBridgeClient{settlementTxHash: "0xsettlement"},
+			}, nil)
+			require.NoError(t, err)
+
+			batch := settleTestBatch(t, ctx, database, settler)
+			assert.Equal(t, dbtypes.SettlementStatusPending, batch.SettlementStatus)
+			assert.Equal(t, dbtypes.SettlementTriggerValueThreshold, batch.SettlementTrigger)
+			assert.Equal(t, int64(2), batch.NumOrders)
+			assert.Equal(t, "2000", batch.TotalValue)
+			assert.Equal(t, "20", batch.TotalProfit)
+
+			for i, orderID := range []string{"aa", "bb"} {
+				var message sql.NullString
+				if tt.settlementStatuses[i] == dbtypes.SettlementStatusFailed {
+					message = sql.NullString{String: "settlement tx reverted", Valid: true}
+				}
+				_, err := database.SetSettlementStatus(ctx, db.SetSettlementStatusParams{
+					SettlementStatus:                  tt.settlementStatuses[i],
+					SettlementStatusMessage:           message,
+					SourceChainID:                     testCosmosChainID,
+					OrderID:                           orderID,
+					SourceChainGatewayContractAddress: testCosmosGateway,
+				})
+				require.NoError(t, err)
+			}
+
+			if tt.transferStatus != "" {
+				require.NoError(t, database.SetSettlementBatchRelaySubmitted(ctx, db.SetSettlementBatchRelaySubmittedParams{
+					MaxRelayTxFeeUusdc: sql.NullString{String: "100", Valid: true},
+					ID:                 batch.ID,
+				}))
+				// the settlement message is sent from the orders destination
+				// chain back to its source chain
+				transfer, err := database.InsertHyperlaneTransfer(ctx, db.InsertHyperlaneTransferParams{
+					SourceChainID:      testEVMChainID,
+					DestinationChainID: testCosmosChainID,
+					MessageID:          "0xmessage",
+					MessageSentTx:      batch.InitiateSettlementTx,
+					TransferStatus:     tt.transferStatus,
+				})
+				require.NoError(t, err)
+
+				for i, tx := range tt.relayTxs {
+					txHash := fmt.Sprintf("0xrelay%d", i)
+					_, err := database.InsertSubmittedTx(ctx, db.InsertSubmittedTxParams{
+						HyperlaneTransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+						ChainID:             testCosmosChainID,
+						TxHash:              txHash,
+						RawTx:               "raw" + txHash,
+						TxType:              dbtypes.TxTypeHyperlaneMessageDelivery,
+						TxStatus:            dbtypes.TxStatusPending,
+					})
+					require.NoError(t, err)
+					_, err = database.SetSubmittedTxStatus(ctx, db.SetSubmittedTxStatusParams{
+						TxStatus:    tx.status,
+						TxCostUusdc: sql.NullString{String: tx.costUUSDC, Valid: tx.costUUSDC != ""},
+						TxHash:      txHash,
+						ChainID:     testCosmosChainID,
+					})
+					require.NoError(t, err)
+				}
+
+				batch, err = database.GetSettlementBatch(ctx, batch.ID)
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, settler.verifySettlementBatch(ctx, batch))
+
+			batch, err = database.GetSettlementBatch(ctx, batch.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, batch.SettlementStatus)
+			assert.Equal(t, tt.expectedStatusMessage, batch.SettlementStatusMessage)
+			assert.Equal(t, tt.expectInitiated, batch.InitiatedAt.Valid)
+			assert.Equal(t, tt.expectCompleted, batch.CompletedAt.Valid)
+			assert.Equal(t, tt.expectedRelayCostUUSDC, batch.RelayCostUusdc)
+			assert.Equal(t, tt.expectedRelayCostUUSDC.Valid, batch.RelayDeliveredAt.Valid)
+		})
+	}
+}
+
+func Test_OrderSettler_SettleOrders(t *testing.T) {
+	maxOrdersPerSettlementBatch := 2
+	ctx := config.ConfigReaderContext(context.Background(), config.NewConfigReader(config.Config{
+		Chains: map[string]config.ChainConfig{
+			"osmosis": {
+				ChainID:                     testCosmosChainID,
+				Type:                        config.ChainType_COSMOS,
+				HyperlaneDomain:             testCosmosHyperlaneID,
+				FastTransferContractAddress: testCosmosGateway,
+				SolverAddress:               testCosmosSolver,
+				BatchUUSDCSettleUpThreshold: "1000",
+				Routes: map[string]config.RouteConfig{
+					testEVMChainID: {MaxOrdersPerSettlementBatch: &maxOrdersPerSettlementBatch},
+				},
+			},
+			"arbitrum": {
+				ChainID:                     testEVMChainID,
+				Type:                        config.ChainType_EVM,
+				HyperlaneDomain:             testEVMChainID,
+				FastTransferContractAddress: testEVMGateway,
+				SolverAddress:               testEVMSolverAddress,
+				BatchUUSDCSettleUpThreshold: "1000",
+			},
+		},
+	}))
+	database := newTestDB(t)
+
+	// the pending settlements are split into a batch of aa and bb that meets
+	// the settle up threshold, and a batch of only cc that does not
+	for _, orderID := range []string{"aa", "bb", "cc"} {
+		_, err := database.InsertOrderSettlement(ctx, db.InsertOrderSettlementParams{
+			SourceChainID:                     testCosmosChainID,
+			DestinationChainID:                testEVMChainID,
+			SourceChainGatewayContractAddress: testCosmosGateway,
+			Amount:                            "500",
+			Profit:                            "5",
+			OrderID:                           orderID,
+			SettlementStatus:                  dbtypes.SettlementStatusPending,
+		})
+		require.NoError(t, err)
+	}
+
+	settler, err := NewOrderSettler(ctx, database, fakeClientManager{
+		testEVMChainID: &fakeBridgeClient{settlementTxHash: "0xsettlement"},
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, settler.settleOrders(ctx))
+
+	expectedInitiateSettlementTxs := map[string]sql.NullString{
+		"aa": {String: "0xsettlement", Valid: true},
+		"bb": {String: "0xsettlement", Valid: true},
+		"cc": {},
+	}
+	for orderID, expectedInitiateSettlementTx := range expectedInitiateSettlementTxs {
+		settlement, err := database.GetOrderSettlement(ctx, db.GetOrderSettlementParams{
+			SourceChainID:                     testCosmosChainID,
+			SourceChainGatewayContractAddress: testCosmosGateway,
+			OrderID:                           orderID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expectedInitiateSettlementTx, settlement.InitiateSettlementTx, orderID)
+		assert.Equal(t, expectedInitiateSettlementTx.Valid, settlement.SettlementBatchID.Valid, orderID)
+	}
+}
@@ -0,0 +1,95 @@
+package ordersettler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/ordersettler"
+	"github.com/skip-mev/go-fast-solver/ordersettler/types"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderSettler_SettlementTrigger(t *testing.T) {
+	maxAge := time.Hour
+	maxBatchCount := 3
+
+	tests := []struct {
+		Name            string
+		Route           config.RouteConfig
+		Amounts         []string
+		OldestAge       time.Duration
+		ExpectedTrigger string
+	}{
+		{
+			Name:            "batch value below threshold without route triggers",
+			Amounts:         []string{"100", "200"},
+			OldestAge:       2 * time.Hour,
+			ExpectedTrigger: "",
+		},
+		{
+			Name:            "batch value reaches threshold",
+			Amounts:         []string{"600", "500"},
+			ExpectedTrigger: dbtypes.SettlementTriggerValueThreshold,
+		},
+		{
+			Name:            "batch count reaches route max batch count",
+			Route:           config.RouteConfig{SettlementMaxBatchCount: &maxBatchCount},
+			Amounts:         []string{"1", "1", "1"},
+			ExpectedTrigger: dbtypes.SettlementTriggerMaxBatchCount,
+		},
+		{
+			Name:            "oldest settlement exceeds route max age",
+			Route:           config.RouteConfig{SettlementMaxAge: &maxAge, SettlementMaxBatchCount: &maxBatchCount},
+			Amounts:         []string{"1", "1"},
+			OldestAge:       2 * time.Hour,
+			ExpectedTrigger: dbtypes.SettlementTriggerMaxAge,
+		},
+		{
+			Name:            "oldest settlement within route max age",
+			Route:           config.RouteConfig{SettlementMaxAge: &maxAge},
+			Amounts:         []string{"1", "1"},
+			OldestAge:       30 * time.Minute,
+			ExpectedTrigger: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ctx := context.Background()
+			cfg := config.Config{
+				Chains: map[string]config.ChainConfig{
+					"ethereum": {
+						ChainID:                     "1",
+						BatchUUSDCSettleUpThreshold: "1000",
+						Routes:                      map[string]config.RouteConfig{"osmosis-1": tt.Route},
+					},
+					"osmosis": {ChainID: "osmosis-1"},
+				},
+			}
+			ctx = config.ConfigReaderContext(ctx, config.NewConfigReader(cfg))
+
+			var batch types.SettlementBatch
+			for i, amount := range tt.Amounts {
+				createdAt := time.Now()
+				if i == 0 {
+					createdAt = createdAt.Add(-tt.OldestAge)
+				}
+				batch = append(batch, db.OrderSettlement{
+					SourceChainID:      "1",
+					DestinationChainID: "osmosis-1",
+					Amount:             amount,
+					CreatedAt:          createdAt,
+				})
+			}
+
+			settler, err := ordersettler.NewOrderSettler(ctx, nil, nil, nil)
+			assert.NoError(t, err)
+			trigger, err := settler.SettlementTrigger(ctx, batch)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ExpectedTrigger, trigger)
+		})
+	}
+}
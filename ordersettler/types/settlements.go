@@ -2,9 +2,13 @@ package types
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math/big"
+	"sort"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	"github.com/skip-mev/go-fast-solver/shared/config"
@@ -64,6 +68,32 @@ func IntoSettlementBatchesByHash(settlements []db.OrderSettlement) []SettlementB
 	return batches
 }
 
+// Split splits the batch into batches of at most maxOrders settlements. The
+// settlements are ordered by their db id, so the oldest settlements are always
+// in the first batch and a batch is split the same way each time. If
+// maxOrders is not positive the batch is not split.
+func (b SettlementBatch) Split(maxOrders int) []SettlementBatch {
+	if maxOrders <= 0 || len(b) <= maxOrders {
+		return []SettlementBatch{b}
+	}
+
+	sorted := make(SettlementBatch, len(b))
+	copy(sorted, b)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	var batches []SettlementBatch
+	for start := 0; start < len(sorted); start += maxOrders {
+		end := start + maxOrders
+		if end > len(sorted) {
+			end = len(sorted)
+		}
+		batches = append(batches, sorted[start:end:end])
+	}
+	return batches
+}
+
 func (b SettlementBatch) OrderIDs() []string {
 	var ids []string
 	for _, settlement := range b {
@@ -117,6 +147,15 @@ func (b SettlementBatch) RepaymentAddress(ctx context.Context) ([]byte, error) {
 			return nil, fmt.Errorf("solver address not set for chain %s", sourceChainConfig.ChainID)
 		}
 		repaymentAddress = common.BytesToHash(common.HexToAddress(sourceChainConfig.SolverAddress).Bytes()).Bytes()
+	case config.ChainType_COSMOS:
+		if sourceChainConfig.SolverAddress == "" {
+			return nil, fmt.Errorf("solver address not set for chain %s", sourceChainConfig.ChainID)
+		}
+		_, addressBytes, err := bech32.DecodeAndConvert(sourceChainConfig.SolverAddress)
+		if err != nil {
+			return nil, fmt.Errorf("decoding bech32 solver address %s for chain %s: %w", sourceChainConfig.SolverAddress, sourceChainConfig.ChainID, err)
+		}
+		repaymentAddress = common.BytesToHash(addressBytes).Bytes()
 	default:
 		return nil, fmt.Errorf("unsupported destination chain type %s for settlement", sourceChainConfig.Type)
 	}
@@ -124,6 +163,32 @@ func (b SettlementBatch) RepaymentAddress(ctx context.Context) ([]byte, error) {
 	return repaymentAddress, nil
 }
 
+// OldestCreatedAt returns the creation time of the oldest settlement in the
+// batch
+func (b SettlementBatch) OldestCreatedAt() time.Time {
+	oldest := b[0].CreatedAt
+	for _, settlement := range b[1:] {
+		if settlement.CreatedAt.Before(oldest) {
+			oldest = settlement.CreatedAt
+		}
+	}
+	return oldest
+}
+
+// SetTrigger records the trigger that caused the batch to be settled on each
+// settlement in the batch
+func (b SettlementBatch) SetTrigger(trigger string) {
+	for i := range b {
+		b[i].SettlementTrigger = sql.NullString{String: trigger, Valid: true}
+	}
+}
+
+// Trigger returns the trigger that caused the batch to be settled, or an
+// empty string if it has not been set
+func (b SettlementBatch) Trigger() string {
+	return b[0].SettlementTrigger.String
+}
+
 func (b SettlementBatch) TotalValue() (*big.Int, error) {
 	sum := big.NewInt(0)
 	for _, settlement := range b {
@@ -150,9 +215,10 @@ func (b SettlementBatch) TotalProfit() (*big.Int, error) {
 
 func (b SettlementBatch) String() string {
 	return fmt.Sprintf(
-		"SourceChainID: %s, DestinationChainID: %s, NumOrdersInBatch: %d",
+		"SourceChainID: %s, DestinationChainID: %s, NumOrdersInBatch: %d, Trigger: %s",
 		b.SourceChainID(),
 		b.DestinationChainID(),
 		len(b),
+		b.Trigger(),
 	)
 }
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlementBatchSplit(t *testing.T) {
+	batch := SettlementBatch{
+		{ID: 4, OrderID: "d"},
+		{ID: 1, OrderID: "a"},
+		{ID: 5, OrderID: "e"},
+		{ID: 3, OrderID: "c"},
+		{ID: 2, OrderID: "b"},
+	}
+
+	batches := batch.Split(2)
+	assert.Len(t, batches, 3)
+	assert.Equal(t, []string{"a", "b"}, batches[0].OrderIDs())
+	assert.Equal(t, []string{"c", "d"}, batches[1].OrderIDs())
+	assert.Equal(t, []string{"e"}, batches[2].OrderIDs())
+
+	// splitting does not reorder the original batch
+	assert.Equal(t, "d", batch[0].OrderID)
+
+	assert.Equal(t, []SettlementBatch{batch}, batch.Split(5))
+	assert.Equal(t, []SettlementBatch{batch}, batch.Split(0))
+}
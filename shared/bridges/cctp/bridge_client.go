@@ -66,14 +66,26 @@ func (e ErrOrderFillEventNotFound) Error() string {
 	return fmt.Sprintf("order fill event not found for order: %s", e.OrderID)
 }
 
+type ErrGatewayDenomMismatch struct {
+	GatewayContractAddress string
+	GatewayDenom           string
+	ConfiguredDenom        string
+}
+
+func (e ErrGatewayDenomMismatch) Error() string {
+	return fmt.Sprintf("gateway %s expects fills in denom %s but usdc denom is configured as %s", e.GatewayContractAddress, e.GatewayDenom, e.ConfiguredDenom)
+}
+
 type BridgeClient interface {
 	BlockHeight(ctx context.Context) (uint64, error)
 	SignerGasTokenBalance(ctx context.Context) (*big.Int, error)
 	FillOrder(ctx context.Context, order db.Order, gatewayContractAddress string) (string, string, *uint64, error)
+	EstimateFillOrderTxFee(ctx context.Context, order db.Order, gatewayContractAddress string) (*big.Int, error)
 	GetTxResult(ctx context.Context, txHash string) (*big.Int, *TxFailure, error)
 	InitiateBatchSettlement(ctx context.Context, batch types.SettlementBatch) (string, string, error)
 	IsSettlementComplete(ctx context.Context, gatewayContractAddress, orderID string) (bool, error)
 	OrderFillsByFiller(ctx context.Context, gatewayContractAddress, fillerAddress string) ([]Fill, error)
+	OrderFillsByFillerPage(ctx context.Context, gatewayContractAddress, fillerAddress string, startAfter *string, limit uint64) ([]Fill, error)
 	QueryOrderFillEvent(ctx context.Context, gatewayContractAddress, orderID string) (*OrderFillEvent, time.Time, error)
 	Balance(ctx context.Context, address, denom string) (*big.Int, error)
 	OrderExists(ctx context.Context, gatewayContractAddress, orderID string, blockNumber *big.Int) (exists bool, amount *big.Int, err error)
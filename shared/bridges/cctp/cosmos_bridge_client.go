@@ -10,6 +10,7 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sdkgrpc "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -55,6 +56,11 @@ type CosmosBridgeClient struct {
 
 	gasPrice float64
 	gasDenom string
+
+	// gatewayDenoms caches the denom that each gateway contract expects
+	// orders to be filled with, keyed by gateway contract address
+	gatewayDenoms     map[string]string
+	gatewayDenomsLock sync.RWMutex
 }
 
 var _ BridgeClient = (*CosmosBridgeClient)(nil)
@@ -93,7 +99,9 @@ func NewCosmosBridgeClient(
 		signer:     signer,
 		gasPrice:   gasPrice,
 		gasDenom:   gasDenom,
-		txExecutor: txSubmitter,
+
+		gatewayDenoms: make(map[string]string),
+		txExecutor:    txSubmitter,
 	}, nil
 }
 
@@ -224,27 +232,68 @@ type FastTransferOrder struct {
 }
 
 func (c *CosmosBridgeClient) FillOrder(ctx context.Context, order db.Order, gatewayContractAddress string) (string, string, *uint64, error) {
-	fromAddress, err := bech32.ConvertAndEncode(c.prefix, c.signer.Address())
+	msgs, err := c.fillOrderMsgs(ctx, order, gatewayContractAddress)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	txHash, tx, err := c.submitTx(ctx, msgs)
 	if err != nil {
 		return "", "", nil, err
 	}
+	txBytes, err := c.txConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return txHash, base64.StdEncoding.EncodeToString(txBytes), nil, err
+}
+
+// EstimateFillOrderTxFee simulates filling order and returns the expected tx
+// fee in the chains gas denom
+func (c *CosmosBridgeClient) EstimateFillOrderTxFee(ctx context.Context, order db.Order, gatewayContractAddress string) (*big.Int, error) {
+	msgs, err := c.fillOrderMsgs(ctx, order, gatewayContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAddress, err := bech32.ConvertAndEncode(c.prefix, c.signer.Address())
+	if err != nil {
+		return nil, err
+	}
+	gasUsed, err := c.txExecutor.EstimateGasUsed(ctx, c.chainID, fromAddress, msgs, c.txConfig, c.signer)
+	if err != nil {
+		return nil, fmt.Errorf("simulating fill order tx: %w", err)
+	}
+
+	gasPrice, err := math.LegacyNewDecFromStr(strconv.FormatFloat(c.gasPrice, 'f', -1, 64))
+	if err != nil {
+		return nil, fmt.Errorf("converting gas price %f to decimal: %w", c.gasPrice, err)
+	}
+	return gasPrice.MulInt64(int64(gasUsed)).Ceil().RoundInt().BigInt(), nil
+}
+
+func (c *CosmosBridgeClient) fillOrderMsgs(ctx context.Context, order db.Order, gatewayContractAddress string) ([]sdk.Msg, error) {
+	fromAddress, err := bech32.ConvertAndEncode(c.prefix, c.signer.Address())
+	if err != nil {
+		return nil, err
+	}
 
 	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
 	if err != nil {
-		return "", "", nil, fmt.Errorf("getting config for source chainID %s: %w", order.SourceChainID, err)
+		return nil, fmt.Errorf("getting config for source chainID %s: %w", order.SourceChainID, err)
 	}
 	sourceHyperlaneDomain, err := strconv.ParseUint(sourceChainConfig.HyperlaneDomain, 10, 64)
 	if err != nil {
-		return "", "", nil, fmt.Errorf("converting source hyperlane domain %s to uint: %w", sourceChainConfig.HyperlaneDomain, err)
+		return nil, fmt.Errorf("converting source hyperlane domain %s to uint: %w", sourceChainConfig.HyperlaneDomain, err)
 	}
 
 	destChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.DestinationChainID)
 	if err != nil {
-		return "", "", nil, fmt.Errorf("getting config for destination chainID %s: %w", order.DestinationChainID, err)
+		return nil, fmt.Errorf("getting config for destination chainID %s: %w", order.DestinationChainID, err)
 	}
 	destHyperlaneDomain, err := strconv.ParseUint(destChainConfig.HyperlaneDomain, 10, 64)
 	if err != nil {
-		return "", "", nil, fmt.Errorf("converting destination hyperlane domain %s to uint: %w", destChainConfig.HyperlaneDomain, err)
+		return nil, fmt.Errorf("converting destination hyperlane domain %s to uint: %w", destChainConfig.HyperlaneDomain, err)
 	}
 
 	fillOrderMsg := &FillOrderEnvelope{
@@ -268,13 +317,28 @@ func (c *CosmosBridgeClient) FillOrder(ctx context.Context, order db.Order, gate
 
 	fillOrderMsgBytes, err := json.Marshal(fillOrderMsg)
 	if err != nil {
-		return "", "", nil, err
+		return nil, err
 	}
 
-	msgs := []sdk.Msg{}
 	amount, ok := math.NewIntFromString(order.AmountOut)
 	if !ok {
-		return "", "", nil, errors.New("invalid amount")
+		return nil, errors.New("invalid amount")
+	}
+
+	usdcDenom, err := config.GetConfigReader(ctx).GetUSDCDenom(c.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting usdc denom for chain %s: %w", c.chainID, err)
+	}
+	gatewayDenom, err := c.gatewayDenom(ctx, gatewayContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("getting fill denom for gateway %s: %w", gatewayContractAddress, err)
+	}
+	if gatewayDenom != usdcDenom {
+		return nil, ErrGatewayDenomMismatch{
+			GatewayContractAddress: gatewayContractAddress,
+			GatewayDenom:           gatewayDenom,
+			ConfiguredDenom:        usdcDenom,
+		}
 	}
 
 	wasmExecuteContractMsg := &wasmtypes.MsgExecuteContract{
@@ -282,20 +346,11 @@ func (c *CosmosBridgeClient) FillOrder(ctx context.Context, order db.Order, gate
 		Contract: gatewayContractAddress,
 		Msg:      fillOrderMsgBytes,
 		Funds: []sdk.Coin{{
-			Denom:  "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+			Denom:  usdcDenom,
 			Amount: amount,
 		}},
 	}
-	msgs = append(msgs, wasmExecuteContractMsg)
-	txHash, tx, err := c.submitTx(ctx, msgs)
-	if err != nil {
-		return "", "", nil, err
-	}
-	txBytes, err := c.txConfig.TxJSONEncoder()(tx)
-	if err != nil {
-		return "", "", nil, err
-	}
-	return txHash, base64.StdEncoding.EncodeToString(txBytes), nil, err
+	return []sdk.Msg{wasmExecuteContractMsg}, nil
 }
 
 type InitiateTimeoutEnvelope struct {
@@ -554,55 +609,50 @@ func parseAmountFromFillTx(tx abcitypes.ExecTxResult, filler string, gatewayCont
 	return nil, fmt.Errorf("could not find transfer event where recipient is %s and sender is %s", gatewayContractAddress, filler)
 }
 
+// IsOrderRefunded checks if an order has been refunded on the source chains
+// gateway contract. If the order has been refunded, the hash of the tx that
+// refunded the order is also returned.
 func (c *CosmosBridgeClient) IsOrderRefunded(ctx context.Context, gatewayContractAddress, orderID string) (bool, string, error) {
-	return false, "", errors.New("not implemented")
+	status, err := c.OrderStatus(ctx, gatewayContractAddress, orderID)
+	if err != nil {
+		return false, "", fmt.Errorf("querying orderID %s status: %w", orderID, err)
+	}
+	if status != fast_transfer_gateway.OrderStatusRefunded {
+		return false, "", nil
+	}
+
+	query := fmt.Sprintf("wasm._contract_address='%s' AND wasm.action='order_refunded' AND wasm.order_id='%s'", gatewayContractAddress, orderID)
+	searchResult, err := c.rpcClient.TxSearch(ctx, query, false, nil, nil, "desc")
+	if err != nil {
+		return false, "", fmt.Errorf("searching for order refund tx for order %s at gateway %s: %w", orderID, gatewayContractAddress, err)
+	}
+	if len(searchResult.Txs) == 0 {
+		return false, "", fmt.Errorf("no refund tx found for orderID %s, but the order is reported as refunded from fast gateway contract", orderID)
+	}
+
+	// txs are sorted by height descending, use the most recent refund
+	return true, searchResult.Txs[0].Hash.String(), nil
 }
 
 type Fill struct {
 	OrderID      string `json:"order_id"`
 	SourceDomain uint32 `json:"source_domain"`
+
+	// AmountOut is the amount the order was filled with. It is only set for
+	// fills read from the solvers db rather than from a gateways fill index,
+	// and is used when the gateway does not record the fill amount.
+	AmountOut *big.Int `json:"-"`
 }
 
 func (c *CosmosBridgeClient) OrderFillsByFiller(ctx context.Context, gatewayContractAddress, fillerAddress string) ([]Fill, error) {
-	wasmQueryClient := wasmtypes.NewQueryClient(c.grpcClient)
 	var startAfter *string
 	const limit uint64 = 100
 
 	var fills []Fill
 	for {
-		query := struct {
-			OrderFillsByFiller struct {
-				Filler     string  `json:"filler"`
-				StartAfter *string `json:"start_after,omitempty"`
-				Limit      uint64  `json:"limit"`
-			} `json:"order_fills_by_filler"`
-		}{
-			OrderFillsByFiller: struct {
-				Filler     string  `json:"filler"`
-				StartAfter *string `json:"start_after,omitempty"`
-				Limit      uint64  `json:"limit"`
-			}{
-				Filler:     fillerAddress,
-				StartAfter: startAfter,
-				Limit:      limit,
-			},
-		}
-		jsonData, err := json.Marshal(query)
+		page, err := c.OrderFillsByFillerPage(ctx, gatewayContractAddress, fillerAddress, startAfter, limit)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal query: %w", err)
-		}
-
-		resp, err := wasmQueryClient.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
-			Address:   gatewayContractAddress,
-			QueryData: jsonData,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to query smart contract state: %w", err)
-		}
-
-		var page []Fill
-		if err := json.Unmarshal(resp.Data, &page); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+			return nil, err
 		}
 
 		fills = append(fills, page...)
@@ -618,6 +668,49 @@ func (c *CosmosBridgeClient) OrderFillsByFiller(ctx context.Context, gatewayCont
 	return fills, nil
 }
 
+// OrderFillsByFillerPage returns up to limit order fills by a filler, ordered
+// by order id, starting after the startAfter order id. If startAfter is nil,
+// fills are returned from the first order id.
+func (c *CosmosBridgeClient) OrderFillsByFillerPage(ctx context.Context, gatewayContractAddress, fillerAddress string, startAfter *string, limit uint64) ([]Fill, error) {
+	wasmQueryClient := wasmtypes.NewQueryClient(c.grpcClient)
+
+	query := struct {
+		OrderFillsByFiller struct {
+			Filler     string  `json:"filler"`
+			StartAfter *string `json:"start_after,omitempty"`
+			Limit      uint64  `json:"limit"`
+		} `json:"order_fills_by_filler"`
+	}{
+		OrderFillsByFiller: struct {
+			Filler     string  `json:"filler"`
+			StartAfter *string `json:"start_after,omitempty"`
+			Limit      uint64  `json:"limit"`
+		}{
+			Filler:     fillerAddress,
+			StartAfter: startAfter,
+			Limit:      limit,
+		},
+	}
+	jsonData, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	resp, err := wasmQueryClient.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   gatewayContractAddress,
+		QueryData: jsonData,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query smart contract state: %w", err)
+	}
+
+	var page []Fill
+	if err := json.Unmarshal(resp.Data, &page); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return page, nil
+}
+
 func (c *CosmosBridgeClient) WaitForTx(ctx context.Context, txHash string) error {
 	return retry.Do(func() error {
 		txHashBytes, err := hex.DecodeString(txHash)
@@ -644,8 +737,68 @@ func (c *CosmosBridgeClient) OrderExists(ctx context.Context, gatewayContractAdd
 	return false, nil, errors.New("not implemented")
 }
 
+// OrderStatus queries the gateway contract for the status of an order and
+// converts it into the order status values used by the evm gateway contract.
 func (c *CosmosBridgeClient) OrderStatus(ctx context.Context, gatewayContractAddress, orderID string) (uint8, error) {
-	return 0, errors.New("not implemented")
+	resp, err := wasmtypes.NewQueryClient(c.grpcClient).SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   gatewayContractAddress,
+		QueryData: []byte(fmt.Sprintf(`{"order_status":{"order_id":"%s"}}`, orderID)),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("querying order status of order %s at gateway %s: %w", orderID, gatewayContractAddress, err)
+	}
+
+	var status string
+	if err := json.Unmarshal(resp.Data, &status); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	switch strings.ToLower(status) {
+	case "unfilled":
+		return fast_transfer_gateway.OrderStatusUnfilled, nil
+	case "filled":
+		return fast_transfer_gateway.OrderStatusFilled, nil
+	case "refunded":
+		return fast_transfer_gateway.OrderStatusRefunded, nil
+	default:
+		return 0, fmt.Errorf("unknown order status %s for order %s at gateway %s", status, orderID, gatewayContractAddress)
+	}
+}
+
+// gatewayDenom queries the gateway contract config for the denom that orders
+// must be filled with. The denom is cached since it does not change for a
+// deployed gateway.
+func (c *CosmosBridgeClient) gatewayDenom(ctx context.Context, gatewayContractAddress string) (string, error) {
+	c.gatewayDenomsLock.RLock()
+	denom, ok := c.gatewayDenoms[gatewayContractAddress]
+	c.gatewayDenomsLock.RUnlock()
+	if ok {
+		return denom, nil
+	}
+
+	resp, err := wasmtypes.NewQueryClient(c.grpcClient).SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   gatewayContractAddress,
+		QueryData: []byte(`{"config":{}}`),
+	})
+	if err != nil {
+		return "", fmt.Errorf("querying config of gateway %s: %w", gatewayContractAddress, err)
+	}
+
+	var gatewayConfig struct {
+		TokenDenom string `json:"token_denom"`
+	}
+	if err := json.Unmarshal(resp.Data, &gatewayConfig); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	if gatewayConfig.TokenDenom == "" {
+		return "", fmt.Errorf("gateway %s config has no token denom", gatewayContractAddress)
+	}
+
+	c.gatewayDenomsLock.Lock()
+	defer c.gatewayDenomsLock.Unlock()
+	c.gatewayDenoms[gatewayContractAddress] = gatewayConfig.TokenDenom
+
+	return gatewayConfig.TokenDenom, nil
 }
 
 func (c *CosmosBridgeClient) Close() {}
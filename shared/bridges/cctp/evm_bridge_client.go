@@ -6,23 +6,43 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
 
 	"github.com/skip-mev/go-fast-solver/db/gen/db"
 	settlement "github.com/skip-mev/go-fast-solver/ordersettler/types"
+	"github.com/skip-mev/go-fast-solver/shared/config"
 	"github.com/skip-mev/go-fast-solver/shared/contracts/fast_transfer_gateway"
 	"github.com/skip-mev/go-fast-solver/shared/contracts/usdc"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
 	"github.com/skip-mev/go-fast-solver/shared/signing"
 	signingevm "github.com/skip-mev/go-fast-solver/shared/signing/evm"
+	evmtxexecutor "github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 )
 
+const (
+	// evmTxExpirationBlocks is the number of blocks after submission that a
+	// tx is expected to have been included by. It is returned to callers as
+	// a hint for when a tx can be considered expired.
+	evmTxExpirationBlocks = 100
+)
+
+// minGatewayAllowance is the usdc allowance below which ApproveGateway
+// re-approves the gateway for the max allowance. It is far larger than any
+// order the solver will fill, so once approved the allowance does not need to
+// be checked while filling.
+var minGatewayAllowance = new(big.Int).Lsh(big.NewInt(1), 128)
+
 type EVMClient interface {
 	bind.DeployBackend
 	bind.ContractBackend
@@ -40,6 +60,9 @@ type EVMBridgeClient struct {
 
 	fromAddress common.Address
 	signer      bind.SignerFn
+	txSigner    signing.Signer
+
+	txExecutor evmtxexecutor.EVMTxExecutor
 }
 
 var _ BridgeClient = (*EVMBridgeClient)(nil)
@@ -48,6 +71,7 @@ func NewEVMBridgeClient(
 	client EVMClient,
 	chainID string,
 	signer signing.Signer,
+	txExecutor evmtxexecutor.EVMTxExecutor,
 ) (*EVMBridgeClient, error) {
 	if signer == nil {
 		signer = signing.NewNopSigner()
@@ -58,6 +82,8 @@ func NewEVMBridgeClient(
 		chainID:     chainID,
 		fromAddress: common.BytesToAddress(signer.Address()),
 		signer:      signingevm.EthereumSignerToBindSignerFn(signer, chainID),
+		txSigner:    signer,
+		txExecutor:  txExecutor,
 	}, nil
 }
 
@@ -80,13 +106,308 @@ func (c *EVMBridgeClient) SignerGasTokenBalance(ctx context.Context) (*big.Int,
 	return balance, nil
 }
 
+// FillOrder fills order on the destination chain. The gateway should already
+// have been approved to transfer the solvers usdc by ApproveGateway, but if
+// its allowance has since run out it is approved again before filling.
 func (c *EVMBridgeClient) FillOrder(ctx context.Context, order db.Order, gatewayContractAddress string) (string, string, *uint64, error) {
-	return "", "", nil, errors.New("not implemented")
+	destChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.DestinationChainID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("getting config for destination chainID %s: %w", order.DestinationChainID, err)
+	}
+	amountOut, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return "", "", nil, fmt.Errorf("converting amount out %s to *big.Int", order.AmountOut)
+	}
+	if err := c.ensureGatewayAllowance(ctx, destChainConfig.USDCDenom, common.HexToAddress(gatewayContractAddress), amountOut); err != nil {
+		return "", "", nil, fmt.Errorf("ensuring usdc allowance for gateway %s: %w", gatewayContractAddress, err)
+	}
+
+	tx, err := c.fillOrderTx(ctx, order, gatewayContractAddress)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	currentHeight, err := c.BlockHeight(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("getting current block height: %w", err)
+	}
+
+	txHash, rawTx, err := c.txExecutor.ExecuteTx(
+		ctx,
+		c.chainID,
+		c.fromAddress.String(),
+		tx.Data(),
+		tx.Value().String(),
+		tx.To().String(),
+		c.txSigner,
+	)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("executing fill order transaction: %w", err)
+	}
+
+	expirationHeight := currentHeight + evmTxExpirationBlocks
+	return txHash, rawTx, &expirationHeight, nil
+}
+
+// EstimateFillOrderTxFee simulates filling order and returns the expected tx
+// fee in wei. No txs are sent, so the fill can only be simulated once the
+// gateway has been approved to transfer the solvers usdc by ApproveGateway.
+func (c *EVMBridgeClient) EstimateFillOrderTxFee(ctx context.Context, order db.Order, gatewayContractAddress string) (*big.Int, error) {
+	destChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.DestinationChainID)
+	if err != nil {
+		return nil, fmt.Errorf("getting config for destination chainID %s: %w", order.DestinationChainID, err)
+	}
+	amountOut, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return nil, fmt.Errorf("converting amount out %s to *big.Int", order.AmountOut)
+	}
+	allowance, err := c.gatewayAllowance(ctx, destChainConfig.USDCDenom, common.HexToAddress(gatewayContractAddress))
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Cmp(amountOut) < 0 {
+		return nil, fmt.Errorf("gateway %s usdc allowance %s is less than order amount out %s", gatewayContractAddress, allowance.String(), amountOut.String())
+	}
+
+	tx, err := c.fillOrderTx(ctx, order, gatewayContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// for a tx that has not been sent, Gas() is the result of calling
+	// eth_estimateGas and GasFeeCap() is the suggested tip cap + base fee of
+	// the current chain head
+	return new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas())), nil
+}
+
+// fillOrderTx creates a signed but unsent fill order tx for order
+func (c *EVMBridgeClient) fillOrderTx(ctx context.Context, order db.Order, gatewayContractAddress string) (*types.Transaction, error) {
+	fastTransferOrder, err := c.toFastTransferOrder(ctx, order)
+	if err != nil {
+		return nil, fmt.Errorf("converting order %s to fast transfer order: %w", order.OrderID, err)
+	}
+
+	fastTransferGateway, err := fast_transfer_gateway.NewFastTransferGateway(common.HexToAddress(gatewayContractAddress), c.client)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := fastTransferGateway.FillOrder(&bind.TransactOpts{
+		From:    c.fromAddress,
+		Context: ctx,
+		Signer:  c.signer,
+		NoSend:  true, // generate the transaction without sending
+	}, c.fromAddress, fastTransferOrder)
+	if err != nil {
+		return nil, fmt.Errorf("creating fill order transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// ApproveGateway approves the gateway contract to transfer the solvers usdc
+// if its allowance is below minGatewayAllowance. It is called once at startup
+// so that fills can be estimated without sending an approval tx.
+func (c *EVMBridgeClient) ApproveGateway(ctx context.Context, gatewayContractAddress string) error {
+	chainConfig, err := config.GetConfigReader(ctx).GetChainConfig(c.chainID)
+	if err != nil {
+		return fmt.Errorf("getting config for chainID %s: %w", c.chainID, err)
+	}
+	if err := c.ensureGatewayAllowance(ctx, chainConfig.USDCDenom, common.HexToAddress(gatewayContractAddress), minGatewayAllowance); err != nil {
+		return fmt.Errorf("ensuring usdc allowance for gateway %s: %w", gatewayContractAddress, err)
+	}
+	return nil
+}
+
+// gatewayAllowance returns the amount of the solvers usdc that the gateway
+// contract is allowed to transfer
+func (c *EVMBridgeClient) gatewayAllowance(ctx context.Context, usdcDenom string, gatewayAddress common.Address) (*big.Int, error) {
+	usdcContract, err := usdc.NewUsdc(common.HexToAddress(usdcDenom), c.client)
+	if err != nil {
+		return nil, fmt.Errorf("creating usdc contract at %s: %w", usdcDenom, err)
+	}
+
+	allowance, err := usdcContract.Allowance(&bind.CallOpts{Context: ctx}, c.fromAddress, gatewayAddress)
+	if err != nil {
+		return nil, fmt.Errorf("querying usdc allowance for solver %s and spender %s: %w", c.fromAddress.String(), gatewayAddress.String(), err)
+	}
+	return allowance, nil
+}
+
+// ensureGatewayAllowance checks that the gateway contract is allowed to
+// transfer at least amount of the solvers usdc. If it is not, the max
+// allowance is approved and this waits for the approval to be included on
+// chain.
+func (c *EVMBridgeClient) ensureGatewayAllowance(ctx context.Context, usdcDenom string, gatewayAddress common.Address, amount *big.Int) error {
+	allowance, err := c.gatewayAllowance(ctx, usdcDenom, gatewayAddress)
+	if err != nil {
+		return err
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil
+	}
+
+	usdcContract, err := usdc.NewUsdc(common.HexToAddress(usdcDenom), c.client)
+	if err != nil {
+		return fmt.Errorf("creating usdc contract at %s: %w", usdcDenom, err)
+	}
+
+	tx, err := usdcContract.Approve(&bind.TransactOpts{
+		From:    c.fromAddress,
+		Context: ctx,
+		Signer:  c.signer,
+		NoSend:  true, // generate the transaction without sending
+	}, gatewayAddress, math.MaxBig256)
+	if err != nil {
+		return fmt.Errorf("creating usdc approval transaction: %w", err)
+	}
+
+	txHash, _, err := c.txExecutor.ExecuteTx(
+		ctx,
+		c.chainID,
+		c.fromAddress.String(),
+		tx.Data(),
+		tx.Value().String(),
+		tx.To().String(),
+		c.txSigner,
+	)
+	if err != nil {
+		return fmt.Errorf("executing usdc approval transaction: %w", err)
+	}
+
+	lmt.Logger(ctx).Info(
+		"submitted usdc approval for fast transfer gateway",
+		zap.String("chainID", c.chainID),
+		zap.String("spender", gatewayAddress.String()),
+		zap.String("txHash", txHash),
+	)
+
+	if err := c.WaitForTx(ctx, txHash); err != nil {
+		return fmt.Errorf("waiting for usdc approval tx %s: %w", txHash, err)
+	}
+	_, failure, err := c.GetTxResult(ctx, txHash)
+	if err != nil {
+		return fmt.Errorf("getting usdc approval tx %s result: %w", txHash, err)
+	}
+	if failure != nil {
+		return fmt.Errorf("usdc approval tx %s failed: %s", txHash, failure.String())
+	}
+
+	return nil
+}
+
+// toFastTransferOrder converts an order from the db into the order struct
+// expected by the fast transfer gateway contract
+func (c *EVMBridgeClient) toFastTransferOrder(ctx context.Context, order db.Order) (fast_transfer_gateway.FastTransferOrder, error) {
+	sourceChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.SourceChainID)
+	if err != nil {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("getting config for source chainID %s: %w", order.SourceChainID, err)
+	}
+	sourceHyperlaneDomain, err := strconv.ParseUint(sourceChainConfig.HyperlaneDomain, 10, 32)
+	if err != nil {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("converting source hyperlane domain %s to uint: %w", sourceChainConfig.HyperlaneDomain, err)
+	}
+
+	destChainConfig, err := config.GetConfigReader(ctx).GetChainConfig(order.DestinationChainID)
+	if err != nil {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("getting config for destination chainID %s: %w", order.DestinationChainID, err)
+	}
+	destHyperlaneDomain, err := strconv.ParseUint(destChainConfig.HyperlaneDomain, 10, 32)
+	if err != nil {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("converting destination hyperlane domain %s to uint: %w", destChainConfig.HyperlaneDomain, err)
+	}
+
+	if len(order.Sender) != 32 {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("expected 32 byte sender but got %d bytes", len(order.Sender))
+	}
+	if len(order.Recipient) != 32 {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("expected 32 byte recipient but got %d bytes", len(order.Recipient))
+	}
+
+	amountIn, ok := new(big.Int).SetString(order.AmountIn, 10)
+	if !ok {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("converting amount in %s to *big.Int", order.AmountIn)
+	}
+	amountOut, ok := new(big.Int).SetString(order.AmountOut, 10)
+	if !ok {
+		return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("converting amount out %s to *big.Int", order.AmountOut)
+	}
+
+	var data []byte
+	if order.Data.Valid {
+		data, err = hex.DecodeString(order.Data.String)
+		if err != nil {
+			return fast_transfer_gateway.FastTransferOrder{}, fmt.Errorf("decoding order data: %w", err)
+		}
+	}
+
+	return fast_transfer_gateway.FastTransferOrder{
+		Sender:            [32]byte(order.Sender),
+		Recipient:         [32]byte(order.Recipient),
+		AmountIn:          amountIn,
+		AmountOut:         amountOut,
+		Nonce:             uint32(order.Nonce),
+		SourceDomain:      uint32(sourceHyperlaneDomain),
+		DestinationDomain: uint32(destHyperlaneDomain),
+		TimeoutTimestamp:  uint64(order.TimeoutTimestamp.UTC().Unix()),
+		Data:              data,
+	}, nil
 }
 
+// InitiateTimeout initiates a timeout for an expired order on the destination
+// chains gateway contract. The hyperlane fee quoted by the gateway for
+// dispatching the timeout message back to the source chain is paid in the
+// chains native gas token.
 func (c *EVMBridgeClient) InitiateTimeout(ctx context.Context, order db.Order, gatewayContractAddress string) (string, string, *uint64, error) {
-	return "", "", nil, errors.New("not implemented")
+	fastTransferOrder, err := c.toFastTransferOrder(ctx, order)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("converting order %s to fast transfer order: %w", order.OrderID, err)
+	}
 
+	fastTransferGateway, err := fast_transfer_gateway.NewFastTransferGateway(
+		common.HexToAddress(gatewayContractAddress),
+		c.client,
+	)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	orders := []fast_transfer_gateway.FastTransferOrder{fastTransferOrder}
+	fee, err := fastTransferGateway.QuoteInitiateTimeout(&bind.CallOpts{Context: ctx}, fastTransferOrder.SourceDomain, orders)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("quoting initiate timeout fee: %w", err)
+	}
+
+	tx, err := fastTransferGateway.InitiateTimeout(&bind.TransactOpts{
+		From:    c.fromAddress,
+		Context: ctx,
+		Signer:  c.signer,
+		Value:   fee,
+		NoSend:  true, // generate the transaction without sending
+	}, orders)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("creating initiate timeout transaction: %w", err)
+	}
+
+	currentHeight, err := c.BlockHeight(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("getting current block height: %w", err)
+	}
+
+	txHash, rawTx, err := c.txExecutor.ExecuteTx(
+		ctx,
+		c.chainID,
+		c.fromAddress.String(),
+		tx.Data(),
+		tx.Value().String(),
+		tx.To().String(),
+		c.txSigner,
+	)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("executing initiate timeout transaction: %w", err)
+	}
+
+	expirationHeight := currentHeight + evmTxExpirationBlocks
+	return txHash, rawTx, &expirationHeight, nil
 }
 
 func (c *EVMBridgeClient) GetTxResult(ctx context.Context, txHash string) (*big.Int, *TxFailure, error) {
@@ -111,8 +432,88 @@ func (c *EVMBridgeClient) GetTxResult(ctx context.Context, txHash string) (*big.
 	return gasCost, nil, nil
 }
 
+// InitiateBatchSettlement posts settlements on chain to a gateway contract address
+// so that funds can be repayed. The hyperlane fee quoted by the gateway for
+// dispatching the settlement message is paid in the chains native gas token.
+// All settlements should have the same source and destination chain.
 func (c *EVMBridgeClient) InitiateBatchSettlement(ctx context.Context, batch settlement.SettlementBatch) (string, string, error) {
-	return "", "", errors.New("not implemented")
+	if len(batch) == 0 {
+		return "", "", nil
+	}
+
+	repaymentAddress, err := batch.RepaymentAddress(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("getting batch repayment address: %w", err)
+	}
+	if len(repaymentAddress) != 32 {
+		return "", "", fmt.Errorf("expected 32 byte repayment address but got %d bytes", len(repaymentAddress))
+	}
+
+	var orderIDs []byte
+	for _, orderID := range batch.OrderIDs() {
+		orderIDBytes, err := decodeOrderID(orderID)
+		if err != nil {
+			return "", "", err
+		}
+		orderIDs = append(orderIDs, orderIDBytes[:]...)
+	}
+
+	sourceChainConfig, err := batch.SourceChainConfig(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("getting batch source chain config: %w", err)
+	}
+	sourceHyperlaneDomain, err := strconv.ParseUint(sourceChainConfig.HyperlaneDomain, 10, 32)
+	if err != nil {
+		return "", "", fmt.Errorf("converting source hyperlane domain %s to uint: %w", sourceChainConfig.HyperlaneDomain, err)
+	}
+
+	gatewayContractAddress, err := batch.DestinationGatewayContractAddress(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("getting batch gateway contract address: %w", err)
+	}
+	fastTransferGateway, err := fast_transfer_gateway.NewFastTransferGateway(
+		common.HexToAddress(gatewayContractAddress),
+		c.client,
+	)
+	if err != nil {
+		return "", "", err
+	}
+
+	fee, err := fastTransferGateway.QuoteInitiateSettlement(
+		&bind.CallOpts{Context: ctx},
+		uint32(sourceHyperlaneDomain),
+		[32]byte(repaymentAddress),
+		orderIDs,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("quoting initiate settlement fee: %w", err)
+	}
+
+	tx, err := fastTransferGateway.InitiateSettlement(&bind.TransactOpts{
+		From:    c.fromAddress,
+		Context: ctx,
+		Signer:  c.signer,
+		Value:   fee,
+		NoSend:  true, // generate the transaction without sending
+	}, [32]byte(repaymentAddress), orderIDs)
+	if err != nil {
+		return "", "", fmt.Errorf("creating initiate settlement transaction: %w", err)
+	}
+
+	txHash, rawTx, err := c.txExecutor.ExecuteTx(
+		ctx,
+		c.chainID,
+		c.fromAddress.String(),
+		tx.Data(),
+		tx.Value().String(),
+		tx.To().String(),
+		c.txSigner,
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("executing initiate settlement transaction: %w", err)
+	}
+
+	return txHash, rawTx, nil
 }
 
 func (c *EVMBridgeClient) IsSettlementComplete(ctx context.Context, gatewayContractAddress, orderID string) (bool, error) {
@@ -123,11 +524,11 @@ func (c *EVMBridgeClient) IsSettlementComplete(ctx context.Context, gatewayContr
 	if err != nil {
 		return false, err
 	}
-	orderIDBytes, err := hex.DecodeString(orderID)
+	orderIDBytes, err := decodeOrderID(orderID)
 	if err != nil {
 		return false, err
 	}
-	orderStatus, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, [32]byte(orderIDBytes))
+	orderStatus, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, orderIDBytes)
 	if err != nil {
 		return false, err
 	}
@@ -149,11 +550,11 @@ func (c *EVMBridgeClient) OrderExists(ctx context.Context, gatewayContractAddres
 	if err != nil {
 		return false, nil, err
 	}
-	orderIDBytes, err := hex.DecodeString(orderID)
+	orderIDBytes, err := decodeOrderID(orderID)
 	if err != nil {
 		return false, nil, err
 	}
-	settlementDetails, err := fastTransferGateway.SettlementDetails(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, [32]byte(orderIDBytes))
+	settlementDetails, err := fastTransferGateway.SettlementDetails(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, orderIDBytes)
 	if err != nil {
 		return false, nil, fmt.Errorf("querying fast transfer gateway for orders settlement details: %w", err)
 	}
@@ -170,19 +571,19 @@ func (c *EVMBridgeClient) IsOrderRefunded(ctx context.Context, gatewayContractAd
 		return false, "", err
 	}
 
-	orderIDBytes, err := hex.DecodeString(orderID)
+	orderIDBytes, err := decodeOrderID(orderID)
 	if err != nil {
 		return false, "", err
 	}
 
-	status, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, [32]byte(orderIDBytes))
+	status, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, orderIDBytes)
 	if err != nil {
 		return false, "", fmt.Errorf("querying orderID %s status: %w", orderID, err)
 	}
 
 	if status == fast_transfer_gateway.OrderStatusRefunded {
 		// Create topic for OrderRefunded event to filter logs for OrderRefunded events with this orderID
-		orderRefundedTopic := [][32]byte{[32]byte(orderIDBytes)}
+		orderRefundedTopic := [][32]byte{orderIDBytes}
 		filterOpts := &bind.FilterOpts{
 			Context: ctx,
 		}
@@ -210,8 +611,54 @@ func (c *EVMBridgeClient) IsOrderRefunded(ctx context.Context, gatewayContractAd
 	return false, "", nil
 }
 
+// QueryOrderFillEvent gets order fill information at the latest block. The
+// time stamp being returned is the time of the block that the fill was
+// queried at, and is used to determine if an unfilled order has timed out.
+// The evm gateway does not emit an event when an order is filled or record the
+// amount that the order was filled with, so the fill tx hash and fill amount
+// are left empty. Callers that need the fill amount must take it from the
+// orders amount out.
 func (c *EVMBridgeClient) QueryOrderFillEvent(ctx context.Context, gatewayContractAddress, orderID string) (*OrderFillEvent, time.Time, error) {
-	return nil, time.Time{}, errors.New("not implemented")
+	fastTransferGateway, err := fast_transfer_gateway.NewFastTransferGateway(
+		common.HexToAddress(gatewayContractAddress),
+		c.client,
+	)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	orderIDBytes, err := decodeOrderID(orderID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	header, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("fetching latest block header: %w", err)
+	}
+	ts := time.Unix(int64(header.Time), 0).UTC()
+
+	fill, err := fastTransferGateway.OrderFills(&bind.CallOpts{Context: ctx, BlockNumber: header.Number}, orderIDBytes)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("querying for order fill of order %s at gateway %s: %w", orderID, gatewayContractAddress, err)
+	}
+	if fill.Filler == (common.Address{}) {
+		return nil, ts, nil
+	}
+
+	return &OrderFillEvent{Filler: fill.Filler.Hex()}, ts, nil
+}
+
+// decodeOrderID decodes a hex encoded, optionally 0x prefixed, 32 byte order id
+func decodeOrderID(orderID string) ([32]byte, error) {
+	orderIDBytes, err := hex.DecodeString(strings.TrimPrefix(orderID, "0x"))
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("decoding order id %s: %w", orderID, err)
+	}
+	if len(orderIDBytes) != 32 {
+		return [32]byte{}, fmt.Errorf("expected 32 byte order id but got %d bytes for order id %s", len(orderIDBytes), orderID)
+	}
+	return [32]byte(orderIDBytes), nil
 }
 
 func (c *EVMBridgeClient) ShouldRetryTx(ctx context.Context, txHash string, submitTime pgtype.Timestamp, txExpirationHeight *uint64) (bool, error) {
@@ -237,12 +684,30 @@ func (c *EVMBridgeClient) BlockHeight(ctx context.Context) (uint64, error) {
 	return resp.Number.Uint64(), nil
 }
 
+// OrderFillsByFiller is not supported by the evm gateway, which does not index
+// order fills by filler or emit an event when an order is filled. The solvers
+// evm fills are instead read from the orders it has recorded as filled.
 func (c *EVMBridgeClient) OrderFillsByFiller(ctx context.Context, gatewayContractAddress, fillerAddress string) ([]Fill, error) {
-	return nil, errors.New("not implemented")
+	return nil, errors.New("evm gateway does not index order fills by filler")
+}
+
+// OrderFillsByFillerPage is not supported by the evm gateway, see
+// OrderFillsByFiller.
+func (c *EVMBridgeClient) OrderFillsByFillerPage(ctx context.Context, gatewayContractAddress, fillerAddress string, startAfter *string, limit uint64) ([]Fill, error) {
+	return nil, errors.New("evm gateway does not index order fills by filler")
 }
 
 func (c *EVMBridgeClient) Balance(ctx context.Context, address, denom string) (*big.Int, error) {
-	return nil, errors.New("not implemented")
+	erc20, err := usdc.NewUsdcCaller(common.HexToAddress(denom), c.client)
+	if err != nil {
+		return nil, fmt.Errorf("creating erc20 contract caller at %s: %w", denom, err)
+	}
+
+	balance, err := erc20.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("querying erc20 balance of %s at contract %s: %w", address, denom, err)
+	}
+	return balance, nil
 }
 
 func (c *EVMBridgeClient) OrderStatus(ctx context.Context, gatewayContractAddress string, orderID string) (uint8, error) {
@@ -254,12 +719,12 @@ func (c *EVMBridgeClient) OrderStatus(ctx context.Context, gatewayContractAddres
 		return 0, err
 	}
 
-	orderIDBytes, err := hex.DecodeString(orderID)
+	orderIDBytes, err := decodeOrderID(orderID)
 	if err != nil {
 		return 0, err
 	}
 
-	status, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, [32]byte(orderIDBytes))
+	status, err := fastTransferGateway.OrderStatuses(&bind.CallOpts{Context: ctx}, orderIDBytes)
 	if err != nil {
 		return 0, fmt.Errorf("querying orderID %s status: %w", orderID, err)
 	}
@@ -276,13 +741,13 @@ func (c *EVMBridgeClient) QueryOrderSubmittedEvent(ctx context.Context, gatewayC
 		return nil, err
 	}
 
-	orderIDBytes, err := hex.DecodeString(orderID)
+	orderIDBytes, err := decodeOrderID(orderID)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create topic for OrderRefunded event to filter logs for OrderRefunded events with this orderID
-	orderSubmittedTopic := [][32]byte{[32]byte(orderIDBytes)}
+	orderSubmittedTopic := [][32]byte{orderIDBytes}
 	filterOpts := &bind.FilterOpts{
 		Context: ctx,
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/skip-mev/go-fast-solver/shared/txexecutor/cosmos"
+	"github.com/skip-mev/go-fast-solver/shared/txexecutor/evm"
 	"sync"
 
 	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
@@ -36,13 +37,15 @@ type ClientManager struct {
 	clients          map[string]cctp.BridgeClient
 	mu               sync.RWMutex
 	cosmosTxExecutor cosmos.CosmosTxExecutor
+	evmTxExecutor    evm.EVMTxExecutor
 }
 
-func NewClientManager(chainIDToPrivateKey keys.KeyStore, cosmosTxExecutor cosmos.CosmosTxExecutor) *ClientManager {
+func NewClientManager(chainIDToPrivateKey keys.KeyStore, cosmosTxExecutor cosmos.CosmosTxExecutor, evmTxExecutor evm.EVMTxExecutor) *ClientManager {
 	return &ClientManager{
 		keyStore:         chainIDToPrivateKey,
 		clients:          make(map[string]cctp.BridgeClient),
 		cosmosTxExecutor: cosmosTxExecutor,
+		evmTxExecutor:    evmTxExecutor,
 	}
 }
 
@@ -207,6 +210,7 @@ func (cm *ClientManager) createEVMClient(
 		client,
 		chainID,
 		signing.NewLocalEthereumSigner(privateKey),
+		cm.evmTxExecutor,
 	)
 
 	return bridgeClient, err
@@ -27,6 +27,10 @@ const (
 	ChainEnvironment_TESTNET ChainEnvironment = "testnet"
 )
 
+// TransferMonitorMaxBlocksPerIteration is the maximum number of blocks the
+// transfer monitor scans for orders on a chain in a single iteration
+const TransferMonitorMaxBlocksPerIteration = 100000
+
 // Config Schema
 type Config struct {
 	Chains                map[string]ChainConfig `yaml:"chains"`
@@ -46,6 +50,31 @@ type OrderFillerConfig struct {
 	// process order fills. Each worker handles filling orders independently to
 	// increase throughput.
 	OrderFillWorkerCount int `yaml:"order_fill_worker_count"`
+	// MaxFillAttempts is the maximum number of fill txs the solver will
+	// submit for a single order. A new fill tx is only submitted once the
+	// previous one has failed on chain or been abandoned. Once all attempts
+	// have been used the order is abandoned. Defaults to 3.
+	MaxFillAttempts int `yaml:"max_fill_attempts"`
+	// FillRetryBackoff is how long the solver waits after a fill tx has failed
+	// or been abandoned before submitting another fill tx for the order. The
+	// backoff doubles after each failed attempt. Defaults to 30s.
+	FillRetryBackoff time.Duration `yaml:"fill_retry_backoff"`
+	// PendingOrderPollInterval is how often the solver reads all pending
+	// orders from the db and queues them to be filled. New orders are queued
+	// as soon as they are ingested, this poll is a fallback that requeues
+	// orders whose fills were deferred (i.e. waiting for block confirmations
+	// or a retry backoff) or that were missed. Defaults to 15s.
+	PendingOrderPollInterval time.Duration `yaml:"pending_order_poll_interval"`
+	// ScreeningListPath is the path to a yaml file containing blocklisted and
+	// allowlisted addresses. Orders sent from or to an address that is
+	// blocklisted, or missing from a non empty allowlist, are abandoned. The
+	// file is reloaded when it is modified. See shared/screening for the file
+	// format. If empty, no orders are screened.
+	ScreeningListPath string `yaml:"screening_list_path"`
+	// MaxUnsettledExposureUUSDC is the maximum amount of uusdc the solver will
+	// have fronted across all chains for orders that have not yet been
+	// settled back to the solver. See ChainConfig.MaxUnsettledExposureUUSDC.
+	MaxUnsettledExposureUUSDC string `yaml:"max_unsettled_exposure_uusdc"`
 }
 
 type MetricsConfig struct {
@@ -114,6 +143,12 @@ type ChainConfig struct {
 	// QuickStartNumBlocksBack specifies how many blocks back to start scanning
 	// from when the solver is initialized
 	QuickStartNumBlocksBack uint64 `yaml:"quick_start_num_blocks_back"`
+	// FinalityWindowBlocks is the number of blocks below the last scanned
+	// height that the transfer monitor rescans on every iteration to detect
+	// reorgs. Orders whose creation block is reorged out of the canonical
+	// chain are marked as reorged. Defaults to 0, i.e. no rescans. Must be
+	// less than the number of blocks scanned per iteration.
+	FinalityWindowBlocks uint64 `yaml:"finality_window_blocks"`
 	// FastTransferContractAddress is the address of the Skip Go Fast Transfer
 	// Protocol contract deployed on this chain
 	FastTransferContractAddress string `yaml:"fast_transfer_contract_address"`
@@ -143,6 +178,14 @@ type ChainConfig struct {
 	// being settled up, not just the profit that will be made.
 	BatchUUSDCSettleUpThreshold string `yaml:"batch_uusdc_settle_up_threshold"`
 
+	// MaxUnsettledExposureUUSDC is the maximum amount of uusdc the solver will
+	// have fronted for orders from this chain that have not yet been settled
+	// back to the solver. This includes filled orders whose settlement has not
+	// completed and fills that are in flight. Once reached, the solver stops
+	// filling orders from this chain until settlements complete. If not set,
+	// exposure from this chain is not limited.
+	MaxUnsettledExposureUUSDC string `yaml:"max_unsettled_exposure_uusdc"`
+
 	// MinProfitMarginBPS is the minimum amount of bps that the solver should
 	// make when settling order batches. This value should be set carefully as
 	// it is used to determine what the max tx fee that should be paid to
@@ -219,6 +262,101 @@ type ChainConfig struct {
 	// never land on chain. The solver will log an error if it sees this
 	// occurring.
 	MinProfitMarginBPS int `yaml:"min_profit_margin_bps"`
+
+	// Routes contains optional per route configuration for orders that are
+	// submitted on this chain, keyed by the orders destination chain id. Any
+	// fill policy values set on a route override the chain level values for
+	// orders on that route.
+	Routes map[string]RouteConfig `yaml:"routes"`
+}
+
+type RouteConfig struct {
+	// Disabled stops the solver from filling orders on this route. Orders
+	// seen on a disabled route will be abandoned.
+	Disabled bool `yaml:"disabled"`
+	// MinFillSize is the minimum amount in of an order on this route that the
+	// solver will fill. Orders below this size will be abandoned. Defaults to
+	// the destination chains cosmos.min_fill_size if set, otherwise there is
+	// no minimum.
+	MinFillSize *big.Int `yaml:"min_fill_size"`
+	// MaxFillSize is the maximum amount in of an order on this route that the
+	// solver will fill. Orders exceeding this size will be abandoned.
+	// Defaults to the destination chains cosmos.max_fill_size if set,
+	// otherwise there is no maximum.
+	MaxFillSize *big.Int `yaml:"max_fill_size"`
+	// MinFeeBps overrides the source chains min_fee_bps for this route
+	MinFeeBps *int `yaml:"min_fee_bps"`
+	// NumBlockConfirmationsBeforeFill overrides the source chains
+	// num_block_confirmations_before_fill for this route
+	NumBlockConfirmationsBeforeFill *int64 `yaml:"num_block_confirmations_before_fill"`
+	// MaxOrderAge is the maximum amount of time after the solver first sees
+	// an order on this route that it will still attempt to fill the order.
+	// Orders older than this will be abandoned. If not set, orders are filled
+	// regardless of their age (up until they time out).
+	MaxOrderAge *time.Duration `yaml:"max_order_age"`
+
+	// MinNetFillProfitUUSDC is the minimum expected net profit in uusdc the
+	// solver must make on an order in order to fill it. The expected net
+	// profit is the solver fee (amount in - amount out) minus the simulated
+	// cost of the fill tx and the orders share of the expected settlement and
+	// relay cost. Orders below this amount will be abandoned. If not set,
+	// orders with an expected net profit of at least 0 are filled.
+	MinNetFillProfitUUSDC string `yaml:"min_net_fill_profit_uusdc"`
+	// ExpectedSettlementCostUUSDC is the expected cost in uusdc of initiating
+	// a batch settlement for this route on the destination chain.
+	ExpectedSettlementCostUUSDC string `yaml:"expected_settlement_cost_uusdc"`
+	// ExpectedRelayCostUUSDC is the expected cost in uusdc of relaying a batch
+	// settlement for this route back to the source chain via hyperlane.
+	//
+	// The expected settlement and relay costs are paid once per batch, so
+	// each order is charged a share of them proportional to its amount in
+	// relative to the source chains BatchUUSDCSettleUpThreshold.
+	ExpectedRelayCostUUSDC string `yaml:"expected_relay_cost_uusdc"`
+
+	// MaxUnsettledExposureUUSDC is the maximum amount of uusdc the solver will
+	// have fronted for orders on this route that have not yet been settled
+	// back to the solver. See ChainConfig.MaxUnsettledExposureUUSDC.
+	MaxUnsettledExposureUUSDC string `yaml:"max_unsettled_exposure_uusdc"`
+
+	// The settlement triggers below are checked in addition to the source
+	// chains BatchUUSDCSettleUpThreshold. A settlement batch for this route is
+	// initiated as soon as any one of them fires.
+
+	// SettlementMaxAge is the maximum amount of time the oldest pending
+	// settlement in a batch on this route can wait before the batch is
+	// settled, regardless of the batch value.
+	SettlementMaxAge *time.Duration `yaml:"settlement_max_age"`
+	// SettlementMaxBatchCount is the number of pending settlements on this
+	// route at which the batch is settled, regardless of the batch value.
+	SettlementMaxBatchCount *int `yaml:"settlement_max_batch_count"`
+	// SettleOnCapitalNeed settles a batch on this route when the solvers uusdc
+	// balance on the source chain (where the settlement is paid out) falls
+	// below the source chains fund rebalancer min_allowed_amount.
+	SettleOnCapitalNeed bool `yaml:"settle_on_capital_need"`
+
+	// MaxOrdersPerSettlementBatch is the maximum number of orders settled by a
+	// single settlement tx on this route. Batches with more orders are split
+	// into multiple settlements, each initiated and relayed separately, so
+	// that a settlement does not exceed the destination chains gas or message
+	// size limits. Each split batch is only settled once one of the routes
+	// settlement triggers fires for it. If not set, all pending orders on the
+	// route are settled together.
+	MaxOrdersPerSettlementBatch *int `yaml:"max_orders_per_settlement_batch"`
+}
+
+// FillPolicy is the policy used to decide whether the solver should fill an
+// order on a route, resolved from the routes config and the chain level
+// defaults of the routes source and destination chains
+type FillPolicy struct {
+	Enabled bool
+	// MinFillSize is nil if there is no min fill size
+	MinFillSize *big.Int
+	// MaxFillSize is nil if there is no max fill size
+	MaxFillSize                     *big.Int
+	MinFeeBps                       int
+	NumBlockConfirmationsBeforeFill int64
+	// MaxOrderAge is 0 if there is no max order age
+	MaxOrderAge time.Duration
 }
 
 type RelayerConfig struct {
@@ -340,6 +478,9 @@ func LoadConfig(path string) (Config, error) {
 			return Config{}, fmt.Errorf("invalid configuration for chain %s: %w", chainID, err)
 		}
 	}
+	if err := validateUUSDCAmount(config.OrderFillerConfig.MaxUnsettledExposureUUSDC); err != nil {
+		return Config{}, fmt.Errorf("invalid order_filler_config max_unsettled_exposure_uusdc: %w", err)
+	}
 
 	return config, nil
 }
@@ -374,6 +515,8 @@ type ConfigReader interface {
 	GetChainIDByHyperlaneDomain(domain string) (string, error)
 
 	GetUSDCDenom(chainID string) (string, error)
+	GetRouteConfig(sourceChainID, destinationChainID string) (RouteConfig, error)
+	GetFillPolicy(sourceChainID, destinationChainID string) (FillPolicy, error)
 
 	GetGasAlertThresholds(chainID string) (warningThreshold, criticalThreshold *big.Int, err error)
 	GetFundRebalancingConfig(chainID string) (FundRebalancerConfig, error)
@@ -518,13 +661,23 @@ func (r configReader) GetGatewayContractAddress(chainID string) (string, error)
 	}
 }
 
+// ErrHyperlaneDomainNotFound is returned when no configured chain has a
+// hyperlane domain
+type ErrHyperlaneDomainNotFound struct {
+	Domain string
+}
+
+func (e ErrHyperlaneDomainNotFound) Error() string {
+	return fmt.Sprintf("no chain found for Hyperlane domain %s", e.Domain)
+}
+
 func (r configReader) GetChainIDByHyperlaneDomain(domain string) (string, error) {
 	for chainID, cfg := range r.chainIDIndex {
 		if cfg.HyperlaneDomain == domain {
 			return chainID, nil
 		}
 	}
-	return "", fmt.Errorf("no chain found for Hyperlane domain %s", domain)
+	return "", ErrHyperlaneDomainNotFound{Domain: domain}
 }
 
 // GetUSDCDenom gets the configured denom for USDC on a given chain (usdc erc20
@@ -538,6 +691,66 @@ func (r configReader) GetUSDCDenom(chainID string) (string, error) {
 	return chainConfig.USDCDenom, nil
 }
 
+// GetRouteConfig gets the configuration for orders submitted on the source
+// chain to be filled on the destination chain. If the route has no
+// configuration, an empty RouteConfig is returned.
+func (r configReader) GetRouteConfig(sourceChainID, destinationChainID string) (RouteConfig, error) {
+	chainConfig, ok := r.chainIDIndex[sourceChainID]
+	if !ok {
+		return RouteConfig{}, fmt.Errorf("chain id %s not found", sourceChainID)
+	}
+
+	return chainConfig.Routes[destinationChainID], nil
+}
+
+// GetFillPolicy gets the policy for filling orders submitted on the source
+// chain to be filled on the destination chain. Values that are not set on the
+// route fall back to the source chains min fee bps and block confirmations,
+// and the destination chains min and max fill sizes.
+func (r configReader) GetFillPolicy(sourceChainID, destinationChainID string) (FillPolicy, error) {
+	sourceChainConfig, ok := r.chainIDIndex[sourceChainID]
+	if !ok {
+		return FillPolicy{}, fmt.Errorf("chain id %s not found", sourceChainID)
+	}
+	destinationChainConfig, ok := r.chainIDIndex[destinationChainID]
+	if !ok {
+		return FillPolicy{}, fmt.Errorf("chain id %s not found", destinationChainID)
+	}
+
+	policy := FillPolicy{
+		Enabled:                         true,
+		MinFeeBps:                       sourceChainConfig.MinFeeBps,
+		NumBlockConfirmationsBeforeFill: sourceChainConfig.NumBlockConfirmationsBeforeFill,
+	}
+	if destinationChainConfig.Cosmos != nil {
+		policy.MinFillSize = destinationChainConfig.Cosmos.MinFillSize
+		policy.MaxFillSize = destinationChainConfig.Cosmos.MaxFillSize
+	}
+
+	route, ok := sourceChainConfig.Routes[destinationChainID]
+	if !ok {
+		return policy, nil
+	}
+	policy.Enabled = !route.Disabled
+	if route.MinFillSize != nil {
+		policy.MinFillSize = route.MinFillSize
+	}
+	if route.MaxFillSize != nil {
+		policy.MaxFillSize = route.MaxFillSize
+	}
+	if route.MinFeeBps != nil {
+		policy.MinFeeBps = *route.MinFeeBps
+	}
+	if route.NumBlockConfirmationsBeforeFill != nil {
+		policy.NumBlockConfirmationsBeforeFill = *route.NumBlockConfirmationsBeforeFill
+	}
+	if route.MaxOrderAge != nil {
+		policy.MaxOrderAge = *route.MaxOrderAge
+	}
+
+	return policy, nil
+}
+
 // GetFundRebalancingConfig returns the fund rebalancing config for a specified chain
 func (r configReader) GetFundRebalancingConfig(chainID string) (FundRebalancerConfig, error) {
 	fundRebalancingConfig, ok := r.config.FundRebalancer[chainID]
@@ -597,6 +810,17 @@ func ValidateChainConfig(chain ChainConfig) error {
 	if chain.Relayer.MailboxAddress == "" {
 		return fmt.Errorf("relayer.mailbox_address is required")
 	}
+	if chain.FinalityWindowBlocks >= TransferMonitorMaxBlocksPerIteration {
+		return fmt.Errorf("finality_window_blocks must be less than %d", TransferMonitorMaxBlocksPerIteration)
+	}
+	if err := validateUUSDCAmount(chain.MaxUnsettledExposureUUSDC); err != nil {
+		return fmt.Errorf("invalid max_unsettled_exposure_uusdc: %w", err)
+	}
+	for destinationChainID, route := range chain.Routes {
+		if err := validateRouteConfig(route, chain); err != nil {
+			return fmt.Errorf("invalid route to %s: %w", destinationChainID, err)
+		}
+	}
 
 	switch chain.Type {
 	case ChainType_COSMOS:
@@ -667,6 +891,73 @@ func validateCosmosConfig(config *CosmosConfig, relayerConfig *RelayerConfig) er
 	return nil
 }
 
+func validateRouteConfig(route RouteConfig, sourceChain ChainConfig) error {
+	if route.MinFillSize != nil && route.MinFillSize.Sign() < 0 {
+		return fmt.Errorf("min_fill_size can not be negative")
+	}
+	if route.MaxFillSize != nil && route.MaxFillSize.Sign() <= 0 {
+		return fmt.Errorf("max_fill_size must be greater than 0")
+	}
+	if route.MinFillSize != nil && route.MaxFillSize != nil && route.MaxFillSize.Cmp(route.MinFillSize) < 0 {
+		return fmt.Errorf("max_fill_size must be greater than min_fill_size")
+	}
+	if route.MinFeeBps != nil {
+		if *route.MinFeeBps < 0 || *route.MinFeeBps > 10000 {
+			return fmt.Errorf("min_fee_bps must be between 0 and 10000")
+		}
+		if sourceChain.MinProfitMarginBPS > *route.MinFeeBps {
+			return fmt.Errorf("min_fee_bps can not be < the source chains min_profit_margin_bps")
+		}
+	}
+	if route.NumBlockConfirmationsBeforeFill != nil && *route.NumBlockConfirmationsBeforeFill < 0 {
+		return fmt.Errorf("num_block_confirmations_before_fill can not be negative")
+	}
+	if route.MaxOrderAge != nil && *route.MaxOrderAge <= 0 {
+		return fmt.Errorf("max_order_age must be greater than 0")
+	}
+	if route.MinNetFillProfitUUSDC != "" {
+		if _, ok := new(big.Int).SetString(route.MinNetFillProfitUUSDC, 10); !ok {
+			return fmt.Errorf("min_net_fill_profit_uusdc must be an integer amount of uusdc")
+		}
+	}
+	if route.ExpectedSettlementCostUUSDC != "" {
+		if cost, ok := new(big.Int).SetString(route.ExpectedSettlementCostUUSDC, 10); !ok || cost.Sign() < 0 {
+			return fmt.Errorf("expected_settlement_cost_uusdc must be a non negative integer amount of uusdc")
+		}
+	}
+	if route.ExpectedRelayCostUUSDC != "" {
+		if cost, ok := new(big.Int).SetString(route.ExpectedRelayCostUUSDC, 10); !ok || cost.Sign() < 0 {
+			return fmt.Errorf("expected_relay_cost_uusdc must be a non negative integer amount of uusdc")
+		}
+	}
+	if err := validateUUSDCAmount(route.MaxUnsettledExposureUUSDC); err != nil {
+		return fmt.Errorf("invalid max_unsettled_exposure_uusdc: %w", err)
+	}
+	if route.SettlementMaxAge != nil && *route.SettlementMaxAge <= 0 {
+		return fmt.Errorf("settlement_max_age must be greater than 0")
+	}
+	if route.SettlementMaxBatchCount != nil && *route.SettlementMaxBatchCount <= 0 {
+		return fmt.Errorf("settlement_max_batch_count must be greater than 0")
+	}
+	if route.MaxOrdersPerSettlementBatch != nil && *route.MaxOrdersPerSettlementBatch <= 0 {
+		return fmt.Errorf("max_orders_per_settlement_batch must be greater than 0")
+	}
+
+	return nil
+}
+
+// validateUUSDCAmount validates that an optional uusdc amount is a non negative
+// integer if it is set
+func validateUUSDCAmount(amount string) error {
+	if amount == "" {
+		return nil
+	}
+	if parsed, ok := new(big.Int).SetString(amount, 10); !ok || parsed.Sign() < 0 {
+		return fmt.Errorf("%s is not a non negative integer amount of uusdc", amount)
+	}
+	return nil
+}
+
 func validateEVMConfig(config *EVMConfig) error {
 	if config.RPC == "" {
 		return fmt.Errorf("evm.rpc is required")
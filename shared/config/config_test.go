@@ -0,0 +1,105 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFillPolicy(t *testing.T) {
+	minFeeBps := 50
+	maxOrderAge := 10 * time.Minute
+	reader := NewConfigReader(Config{
+		Chains: map[string]ChainConfig{
+			"1": {
+				ChainID:                         "1",
+				Type:                            ChainType_EVM,
+				MinFeeBps:                       10,
+				NumBlockConfirmationsBeforeFill: 3,
+				Routes: map[string]RouteConfig{
+					"osmosis-1": {
+						MaxFillSize: big.NewInt(500),
+						MinFeeBps:   &minFeeBps,
+						MaxOrderAge: &maxOrderAge,
+					},
+					"10": {
+						Disabled: true,
+					},
+				},
+			},
+			"10": {
+				ChainID:                         "10",
+				Type:                            ChainType_EVM,
+				MinFeeBps:                       20,
+				NumBlockConfirmationsBeforeFill: 1,
+			},
+			"osmosis-1": {
+				ChainID: "osmosis-1",
+				Type:    ChainType_COSMOS,
+				Cosmos: &CosmosConfig{
+					MinFillSize: big.NewInt(100),
+					MaxFillSize: big.NewInt(1000),
+				},
+			},
+		},
+	})
+
+	// route values override chain level values
+	policy, err := reader.GetFillPolicy("1", "osmosis-1")
+	require.NoError(t, err)
+	assert.True(t, policy.Enabled)
+	assert.Equal(t, big.NewInt(100), policy.MinFillSize)
+	assert.Equal(t, big.NewInt(500), policy.MaxFillSize)
+	assert.Equal(t, 50, policy.MinFeeBps)
+	assert.Equal(t, int64(3), policy.NumBlockConfirmationsBeforeFill)
+	assert.Equal(t, maxOrderAge, policy.MaxOrderAge)
+
+	policy, err = reader.GetFillPolicy("1", "10")
+	require.NoError(t, err)
+	assert.False(t, policy.Enabled)
+
+	// evm destinations without a route have no fill size limits
+	policy, err = reader.GetFillPolicy("10", "1")
+	require.NoError(t, err)
+	assert.True(t, policy.Enabled)
+	assert.Nil(t, policy.MinFillSize)
+	assert.Nil(t, policy.MaxFillSize)
+	assert.Equal(t, 20, policy.MinFeeBps)
+	assert.Equal(t, int64(1), policy.NumBlockConfirmationsBeforeFill)
+	assert.Zero(t, policy.MaxOrderAge)
+
+	_, err = reader.GetFillPolicy("1", "unknown")
+	assert.Error(t, err)
+}
+
+func TestValidateRouteConfig(t *testing.T) {
+	negativeFeeBps := -1
+	zeroAge := time.Duration(0)
+	tests := []struct {
+		name      string
+		route     RouteConfig
+		expectErr bool
+	}{
+		{name: "empty route", route: RouteConfig{}},
+		{name: "valid fill sizes", route: RouteConfig{MinFillSize: big.NewInt(1), MaxFillSize: big.NewInt(2)}},
+		{name: "max below min", route: RouteConfig{MinFillSize: big.NewInt(2), MaxFillSize: big.NewInt(1)}, expectErr: true},
+		{name: "negative min fee bps", route: RouteConfig{MinFeeBps: &negativeFeeBps}, expectErr: true},
+		{name: "zero max order age", route: RouteConfig{MaxOrderAge: &zeroAge}, expectErr: true},
+		{name: "invalid min net fill profit", route: RouteConfig{MinNetFillProfitUUSDC: "abc"}, expectErr: true},
+		{name: "negative expected relay cost", route: RouteConfig{ExpectedRelayCostUUSDC: "-1"}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRouteConfig(tt.route, ChainConfig{})
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -23,6 +23,8 @@ const (
 	transactionTypeLabel    = "transaction_type"
 	gasBalanceLevelLabel    = "gas_balance_level"
 	gasTokenSymbolLabel     = "gas_token_symbol"
+	denomLabel              = "denom"
+	capTypeLabel            = "cap_type"
 	chainNameLabel          = "chain_name"
 )
 
@@ -45,9 +47,13 @@ type Metrics interface {
 
 	ObserveTransferSizeOutOfRange(sourceChainID, destinationChainID string, amountOutOfRange int64)
 	ObserveFeeBpsRejection(sourceChainID, destinationChainID string, feeBpsExceededBy int64)
+	ObserveFillProfitRejection(sourceChainID, destinationChainID string, profitShortfallUUSDC int64)
 	ObserveInsufficientBalanceError(chainID string, amountInsufficientBy uint64)
 
 	SetGasBalance(chainID, chainName, gasTokenSymbol string, gasBalance, warningThreshold, criticalThreshold big.Int, gasTokenDecimals uint8)
+	SetInventory(chainID, denom string, balance, reserved big.Int)
+	SetUnsettledExposure(sourceChainID, destinationChainID string, exposureUUSDC big.Int)
+	IncExposureCapReached(sourceChainID, destinationChainID, capType string)
 
 	IncExcessiveOrderFulfillmentLatency(sourceChainID, destinationChainID, orderStatus string)
 	IncExcessiveOrderSettlementLatency(sourceChainID, destinationChainID, settlementStatus string)
@@ -93,10 +99,17 @@ type PromMetrics struct {
 
 	transferSizeOutOfRange    metrics.Histogram
 	feeBpsRejections          metrics.Histogram
+	fillProfitRejections      metrics.Histogram
 	insufficientBalanceErrors metrics.Histogram
 
 	gasBalance      metrics.Gauge
 	gasBalanceState metrics.Gauge
+
+	inventoryBalance  metrics.Gauge
+	inventoryReserved metrics.Gauge
+
+	unsettledExposure  metrics.Gauge
+	exposureCapReached metrics.Counter
 }
 
 func NewPromMetrics() Metrics {
@@ -197,6 +210,18 @@ func NewPromMetrics() Metrics {
 			Help:      "histogram of fee bps that were rejected for being too low",
 			Buckets:   []float64{1, 5, 10, 25, 50, 100, 200, 500, 1000},
 		}, []string{sourceChainIDLabel, destinationChainIDLabel}),
+		fillProfitRejections: prom.NewHistogramFrom(stdprom.HistogramOpts{
+			Namespace: "solver",
+			Name:      "fill_profit_rejections",
+			Help:      "histogram of uusdc amounts that rejected orders expected net fill profit fell short of the configured min net fill profit by",
+			Buckets: []float64{
+				10000,     // 0.01 USDC
+				100000,    // 0.1 USDC
+				1000000,   // 1 USDC
+				10000000,  // 10 USDC
+				100000000, // 100 USDC
+			},
+		}, []string{sourceChainIDLabel, destinationChainIDLabel}),
 		insufficientBalanceErrors: prom.NewHistogramFrom(stdprom.HistogramOpts{
 			Namespace: "solver",
 			Name:      "insufficient_balance_errors",
@@ -219,6 +244,26 @@ func NewPromMetrics() Metrics {
 			Name:      "gas_balance_state_gauge",
 			Help:      "gas balance states (0=ok 1=warning 2=critical), paginated by chain id",
 		}, []string{chainIDLabel, chainNameLabel}),
+		inventoryBalance: prom.NewGaugeFrom(stdprom.GaugeOpts{
+			Namespace: "solver",
+			Name:      "inventory_balance_gauge",
+			Help:      "on chain solver inventory balances, paginated by chain id and denom",
+		}, []string{chainIDLabel, denomLabel}),
+		inventoryReserved: prom.NewGaugeFrom(stdprom.GaugeOpts{
+			Namespace: "solver",
+			Name:      "inventory_reserved_gauge",
+			Help:      "solver inventory reserved for in flight order fills, paginated by chain id and denom",
+		}, []string{chainIDLabel, denomLabel}),
+		unsettledExposure: prom.NewGaugeFrom(stdprom.GaugeOpts{
+			Namespace: "solver",
+			Name:      "unsettled_exposure_gauge",
+			Help:      "uusdc fronted for filled and in flight orders that has not been settled back to the solver, paginated by source and destination chain",
+		}, []string{sourceChainIDLabel, destinationChainIDLabel}),
+		exposureCapReached: prom.NewCounterFrom(stdprom.CounterOpts{
+			Namespace: "solver",
+			Name:      "exposure_cap_reached_counter",
+			Help:      "number of order fills skipped because they would exceed an unsettled exposure cap, paginated by source and destination chain, and cap type",
+		}, []string{sourceChainIDLabel, destinationChainIDLabel, capTypeLabel}),
 	}
 }
 
@@ -283,6 +328,13 @@ func (m *PromMetrics) ObserveFeeBpsRejection(sourceChainID, destinationChainID s
 	).Observe(float64(feeBps))
 }
 
+func (m *PromMetrics) ObserveFillProfitRejection(sourceChainID, destinationChainID string, profitShortfallUUSDC int64) {
+	m.fillProfitRejections.With(
+		sourceChainIDLabel, sourceChainID,
+		destinationChainIDLabel, destinationChainID,
+	).Observe(float64(profitShortfallUUSDC))
+}
+
 func (m *PromMetrics) ObserveInsufficientBalanceError(chainID string, difference uint64) {
 	m.insufficientBalanceErrors.With(
 		chainIDLabel, chainID,
@@ -304,6 +356,22 @@ func (m *PromMetrics) SetGasBalance(chainID, chainName, gasTokenSymbol string, g
 	m.gasBalance.With(chainIDLabel, chainID, chainNameLabel, chainName, gasTokenSymbolLabel, gasTokenSymbol).Set(gasTokenAmount)
 }
 
+func (m *PromMetrics) SetUnsettledExposure(sourceChainID, destinationChainID string, exposureUUSDC big.Int) {
+	exposureFloat, _ := exposureUUSDC.Float64()
+	m.unsettledExposure.With(sourceChainIDLabel, sourceChainID, destinationChainIDLabel, destinationChainID).Set(exposureFloat)
+}
+
+func (m *PromMetrics) IncExposureCapReached(sourceChainID, destinationChainID, capType string) {
+	m.exposureCapReached.With(sourceChainIDLabel, sourceChainID, destinationChainIDLabel, destinationChainID, capTypeLabel, capType).Add(1)
+}
+
+func (m *PromMetrics) SetInventory(chainID, denom string, balance, reserved big.Int) {
+	balanceFloat, _ := balance.Float64()
+	reservedFloat, _ := reserved.Float64()
+	m.inventoryBalance.With(chainIDLabel, chainID, denomLabel, denom).Set(balanceFloat)
+	m.inventoryReserved.With(chainIDLabel, chainID, denomLabel, denom).Set(reservedFloat)
+}
+
 func (m *PromMetrics) IncExcessiveOrderFulfillmentLatency(sourceChainID, destinationChainID, orderStatus string) {
 	m.excessiveOrderFulfillmentLatency.With(
 		sourceChainIDLabel, sourceChainID,
@@ -362,6 +430,17 @@ func (n NoOpMetrics) ObserveTransferSizeOutOfRange(sourceChainID, destinationCha
 func (n *NoOpMetrics) SetGasBalance(chainID, chainName, gasTokenSymbol string, gasBalance, warningThreshold, criticalThreshold big.Int, gasTokenDecimals uint8) {
 }
 func (n NoOpMetrics) ObserveFeeBpsRejection(sourceChainID, destinationChainID string, feeBps int64) {}
+func (n *NoOpMetrics) SetUnsettledExposure(sourceChainID, destinationChainID string, exposureUUSDC big.Int) {
+}
+
+func (n *NoOpMetrics) IncExposureCapReached(sourceChainID, destinationChainID, capType string) {
+}
+
+func (n *NoOpMetrics) SetInventory(chainID, denom string, balance, reserved big.Int) {
+}
+
+func (n NoOpMetrics) ObserveFillProfitRejection(sourceChainID, destinationChainID string, profitShortfallUUSDC int64) {
+}
 func NewNoOpMetrics() Metrics {
 	return &NoOpMetrics{}
 }
@@ -0,0 +1,68 @@
+// This package defines an in process bus that new orders are published to as
+// soon as they are ingested, so that components acting on orders do not have
+// to poll the db for them.
+
+package orderbus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+)
+
+const (
+	defaultSubscriberBufferSize = 100
+)
+
+// OrderBus delivers published orders to all current subscribers. Publishing
+// never blocks, if a subscribers buffer is full the order is dropped for that
+// subscriber, so subscribers must have a fallback (i.e. db polling) that
+// eventually picks up dropped orders.
+type OrderBus struct {
+	lock        sync.RWMutex
+	subscribers map[chan db.Order]struct{}
+	bufferSize  int
+}
+
+func NewOrderBus() *OrderBus {
+	return &OrderBus{
+		subscribers: make(map[chan db.Order]struct{}),
+		bufferSize:  defaultSubscriberBufferSize,
+	}
+}
+
+// Publish delivers an order to all subscribers. Returns the number of
+// subscribers the order was dropped for because their buffer was full.
+func (b *OrderBus) Publish(order db.Order) (dropped int) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- order:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// Subscribe returns a channel that receives all orders published after the
+// call. The subscription is removed and the channel closed when ctx is done.
+func (b *OrderBus) Subscribe(ctx context.Context) <-chan db.Order {
+	subscriber := make(chan db.Order, b.bufferSize)
+
+	b.lock.Lock()
+	b.subscribers[subscriber] = struct{}{}
+	b.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.lock.Lock()
+		delete(b.subscribers, subscriber)
+		b.lock.Unlock()
+		close(subscriber)
+	}()
+	return subscriber
+}
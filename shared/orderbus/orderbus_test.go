@@ -0,0 +1,39 @@
+package orderbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := NewOrderBus()
+	bus.bufferSize = 1
+
+	first := bus.Subscribe(ctx)
+	second := bus.Subscribe(context.Background())
+
+	assert.Equal(t, 0, bus.Publish(db.Order{ID: 1}))
+	assert.Equal(t, int64(1), (<-first).ID)
+
+	// the second subscribers buffer is full so the order is dropped for it
+	assert.Equal(t, 1, bus.Publish(db.Order{ID: 2}))
+	assert.Equal(t, int64(2), (<-first).ID)
+	assert.Equal(t, int64(1), (<-second).ID)
+
+	// cancelled subscriptions are closed and no longer receive orders
+	cancel()
+	select {
+	case _, ok := <-first:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "timed out waiting for subscription to close")
+	}
+	assert.Equal(t, 0, bus.Publish(db.Order{ID: 3}))
+	assert.Equal(t, int64(3), (<-second).ID)
+}
@@ -0,0 +1,257 @@
+// This package screens the addresses of orders against blocklists and
+// allowlists loaded from a file, so that the solver refuses to fill orders to
+// or from blocked addresses. The file is reloaded at runtime when it changes.
+
+package screening
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	reloadInterval = 30 * time.Second
+	addressLength  = 32
+)
+
+// ListFile is the format of the screening list file. Addresses are either hex
+// encoded with a 0x prefix (i.e. evm addresses) or bech32 encoded (i.e. cosmos
+// addresses).
+type ListFile struct {
+	// Blocklist contains addresses that orders may not be sent from or to
+	Blocklist []string `yaml:"blocklist"`
+	// Allowlist contains the only addresses that orders may be sent from or
+	// to. If empty, all addresses that are not blocklisted are allowed.
+	Allowlist []string `yaml:"allowlist"`
+	// ScreenDataTarget enables screening the contract that an orders data
+	// targets on the destination chain, in addition to its sender and
+	// recipient. Only wasm hook data can be decoded, so when enabled orders
+	// with any other data (e.g. evm calldata) are blocked.
+	ScreenDataTarget bool `yaml:"screen_data_target"`
+}
+
+// lists are the parsed screening lists, keyed by 32 byte left padded hex
+// encoded address with the value being the address as written in the file
+type lists struct {
+	blocked          map[string]string
+	allowed          map[string]string
+	screenDataTarget bool
+}
+
+// screenedAddress is an address of an order and the role it has in the order
+type screenedAddress struct {
+	role    string
+	address []byte
+}
+
+type Screener struct {
+	path    string
+	lock    sync.RWMutex
+	lists   lists
+	modTime time.Time
+}
+
+// NewScreener creates a screener using the lists in the file at path. If path
+// is empty, no orders are blocked.
+func NewScreener(path string) (*Screener, error) {
+	screener := &Screener{path: path}
+	if path == "" {
+		return screener, nil
+	}
+	if err := screener.Reload(); err != nil {
+		return nil, err
+	}
+	return screener, nil
+}
+
+// Reload reloads the screening lists from the file. If the file can not be
+// loaded the previous lists are kept.
+func (s *Screener) Reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("reading screening list file %s: %w", s.path, err)
+	}
+	listBytes, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("reading screening list file %s: %w", s.path, err)
+	}
+	var listFile ListFile
+	if err := yaml.Unmarshal(listBytes, &listFile); err != nil {
+		return fmt.Errorf("unmarshalling screening list file %s: %w", s.path, err)
+	}
+
+	blocked, err := parseAddresses(listFile.Blocklist)
+	if err != nil {
+		return fmt.Errorf("parsing blocklist: %w", err)
+	}
+	allowed, err := parseAddresses(listFile.Allowlist)
+	if err != nil {
+		return fmt.Errorf("parsing allowlist: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lists = lists{
+		blocked:          blocked,
+		allowed:          allowed,
+		screenDataTarget: listFile.ScreenDataTarget,
+	}
+	s.modTime = info.ModTime()
+	return nil
+}
+
+// Start reloads the screening lists whenever the file is modified
+func (s *Screener) Start(ctx context.Context) error {
+	if s.path == "" {
+		return nil
+	}
+	lmt.Logger(ctx).Info("Starting screening list reloader", zap.String("path", s.path))
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			info, err := os.Stat(s.path)
+			if err != nil {
+				lmt.Logger(ctx).Error("failed to stat screening list file", zap.String("path", s.path), zap.Error(err))
+				continue
+			}
+			s.lock.RLock()
+			modified := !info.ModTime().Equal(s.modTime)
+			s.lock.RUnlock()
+			if !modified {
+				continue
+			}
+
+			if err := s.Reload(); err != nil {
+				lmt.Logger(ctx).Error("failed to reload screening lists, keeping previous lists", zap.Error(err))
+				continue
+			}
+			lmt.Logger(ctx).Info("reloaded screening lists", zap.String("path", s.path))
+		}
+	}
+}
+
+// ScreenOrder checks an orders sender, recipient and, if enabled, data target
+// against the screening lists. Returns false and the reason the order is
+// blocked if any of them is blocklisted or missing from a non empty allowlist.
+func (s *Screener) ScreenOrder(order db.Order) (allowed bool, reason string) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	addresses := []screenedAddress{
+		{role: "sender", address: order.Sender},
+		{role: "recipient", address: order.Recipient},
+	}
+	if s.lists.screenDataTarget {
+		target, err := dataTarget(order)
+		if err != nil {
+			return false, fmt.Sprintf("order data target could not be screened: %s", err.Error())
+		}
+		if target != nil {
+			addresses = append(addresses, screenedAddress{role: "data target", address: target})
+		}
+	}
+
+	for _, a := range addresses {
+		key := addressKey(a.address)
+		if entry, ok := s.lists.blocked[key]; ok {
+			return false, fmt.Sprintf("order %s %s is blocklisted", a.role, entry)
+		}
+		if len(s.lists.allowed) > 0 {
+			if _, ok := s.lists.allowed[key]; !ok {
+				return false, fmt.Sprintf("order %s 0x%s is not allowlisted", a.role, key)
+			}
+		}
+	}
+	return true, ""
+}
+
+func parseAddresses(entries []string) (map[string]string, error) {
+	addresses := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		address, err := decodeAddress(entry)
+		if err != nil {
+			return nil, fmt.Errorf("decoding address %s: %w", entry, err)
+		}
+		addresses[addressKey(address)] = entry
+	}
+	return addresses, nil
+}
+
+// decodeAddress decodes a 0x prefixed hex or bech32 encoded address
+func decodeAddress(address string) ([]byte, error) {
+	if strings.HasPrefix(address, "0x") {
+		decoded, err := hex.DecodeString(address[2:])
+		if err != nil {
+			return nil, err
+		}
+		if len(decoded) > addressLength {
+			return nil, fmt.Errorf("expected at most %d bytes but got %d", addressLength, len(decoded))
+		}
+		return decoded, nil
+	}
+	_, decoded, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) > addressLength {
+		return nil, fmt.Errorf("expected at most %d bytes but got %d", addressLength, len(decoded))
+	}
+	return decoded, nil
+}
+
+// addressKey left pads an address to 32 bytes, the length of addresses in
+// orders, and hex encodes it
+func addressKey(address []byte) string {
+	padded := make([]byte, addressLength)
+	if len(address) > addressLength {
+		address = address[len(address)-addressLength:]
+	}
+	copy(padded[addressLength-len(address):], address)
+	return hex.EncodeToString(padded)
+}
+
+// dataTarget returns the address of the contract targeted by an orders data,
+// or nil if the order has no data. Returns an error if the data is not a wasm
+// hook message (i.e. {"wasm":{"contract":"..."}}).
+func dataTarget(order db.Order) ([]byte, error) {
+	if !order.Data.Valid || order.Data.String == "" {
+		return nil, nil
+	}
+	data, err := hex.DecodeString(order.Data.String)
+	if err != nil {
+		return nil, fmt.Errorf("hex decoding data: %w", err)
+	}
+	var hook struct {
+		Wasm struct {
+			Contract string `json:"contract"`
+		} `json:"wasm"`
+	}
+	if err := json.Unmarshal(data, &hook); err != nil {
+		return nil, fmt.Errorf("data is not a wasm hook message: %w", err)
+	}
+	if hook.Wasm.Contract == "" {
+		return nil, fmt.Errorf("data is not a wasm hook message")
+	}
+	target, err := decodeAddress(hook.Wasm.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("decoding wasm hook contract %s: %w", hook.Wasm.Contract, err)
+	}
+	return target, nil
+}
@@ -0,0 +1,77 @@
+package screening
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func paddedAddress(t *testing.T, hexAddress string) []byte {
+	address, err := hex.DecodeString(hexAddress)
+	require.NoError(t, err)
+	return append(make([]byte, 32-len(address)), address...)
+}
+
+func TestScreenOrder(t *testing.T) {
+	blockedEVM := "1111111111111111111111111111111111111111"
+	other := "2222222222222222222222222222222222222222"
+	contractBytes := make([]byte, 32)
+	contractBytes[31] = 3
+	contract, err := bech32.ConvertAndEncode("osmo", contractBytes)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "screening.yml")
+	require.NoError(t, os.WriteFile(path, []byte("blocklist:\n  - \"0x"+blockedEVM+"\"\n  - \""+contract+"\"\nscreen_data_target: true\n"), 0o644))
+
+	screener, err := NewScreener(path)
+	require.NoError(t, err)
+
+	allowed, reason := screener.ScreenOrder(db.Order{Sender: paddedAddress(t, other), Recipient: paddedAddress(t, other)})
+	assert.True(t, allowed)
+	assert.Empty(t, reason)
+
+	allowed, reason = screener.ScreenOrder(db.Order{Sender: paddedAddress(t, other), Recipient: paddedAddress(t, blockedEVM)})
+	assert.False(t, allowed)
+	assert.Equal(t, "order recipient 0x"+blockedEVM+" is blocklisted", reason)
+
+	data := hex.EncodeToString([]byte(`{"wasm":{"contract":"` + contract + `","msg":{}}}`))
+	allowed, reason = screener.ScreenOrder(db.Order{
+		Sender:    paddedAddress(t, other),
+		Recipient: paddedAddress(t, other),
+		Data:      sql.NullString{String: data, Valid: true},
+	})
+	assert.False(t, allowed)
+	assert.Equal(t, "order data target "+contract+" is blocklisted", reason)
+
+	// data that is not a wasm hook message can not be screened so is blocked
+	allowed, reason = screener.ScreenOrder(db.Order{
+		Sender:    paddedAddress(t, other),
+		Recipient: paddedAddress(t, other),
+		Data:      sql.NullString{String: "a9059cbb", Valid: true},
+	})
+	assert.False(t, allowed)
+	assert.Contains(t, reason, "order data target could not be screened")
+
+	// reloading an allowlist only allows listed addresses
+	require.NoError(t, os.WriteFile(path, []byte("allowlist:\n  - \"0x"+other+"\"\n"), 0o644))
+	require.NoError(t, screener.Reload())
+
+	allowed, _ = screener.ScreenOrder(db.Order{Sender: paddedAddress(t, other), Recipient: paddedAddress(t, other)})
+	assert.True(t, allowed)
+	allowed, reason = screener.ScreenOrder(db.Order{Sender: paddedAddress(t, blockedEVM), Recipient: paddedAddress(t, other)})
+	assert.False(t, allowed)
+	assert.Equal(t, "order sender 0x"+hex.EncodeToString(paddedAddress(t, blockedEVM))+" is not allowlisted", reason)
+
+	// invalid lists are rejected and the previous lists are kept
+	require.NoError(t, os.WriteFile(path, []byte("blocklist:\n  - \"0xzz\"\n"), 0o644))
+	assert.Error(t, screener.Reload())
+	allowed, _ = screener.ScreenOrder(db.Order{Sender: paddedAddress(t, other), Recipient: paddedAddress(t, other)})
+	assert.True(t, allowed)
+}
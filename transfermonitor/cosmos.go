@@ -0,0 +1,149 @@
+package transfermonitor
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/contracts/fast_transfer_gateway"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"go.uber.org/zap"
+)
+
+const (
+	// cosmosTxSearchPageSize is the number of txs requested per page when
+	// searching for order submitted txs on a cosmos chain
+	cosmosTxSearchPageSize = 100
+
+	// orderSubmittedAction is the value of the action attribute emitted by the
+	// cosmwasm fast transfer gateway contract when an order is submitted
+	orderSubmittedAction = "order_submitted"
+)
+
+// findNewTransferIntentsOnCosmosChain searches for orders submitted to the
+// fast transfer gateway contract on a cosmos chain between startBlockHeight and
+// the chains latest height (capped at maxBlocksProcessedPerIteration blocks).
+// It returns the orders found and the last height that was searched.
+func (t *TransferMonitor) findNewTransferIntentsOnCosmosChain(ctx context.Context, chain config.ChainConfig, startBlockHeight uint64) ([]Order, uint64, error) {
+	client, err := t.tmRPCManager.GetClient(ctx, chain.ChainID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting tendermint rpc client for chain %s: %w", chain.ChainID, err)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching status of chain %s: %w", chain.ChainID, err)
+	}
+
+	endBlockHeight := math.Min(uint64(status.SyncInfo.LatestBlockHeight), startBlockHeight+maxBlocksProcessedPerIteration)
+
+	query := fmt.Sprintf(
+		"wasm._contract_address='%s' AND wasm.action='%s' AND tx.height>=%d AND tx.height<=%d",
+		chain.FastTransferContractAddress,
+		orderSubmittedAction,
+		startBlockHeight,
+		endBlockHeight,
+	)
+
+	var orders []Order
+	perPage := cosmosTxSearchPageSize
+	for page := 1; ; page++ {
+		searchResult, err := client.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, 0, fmt.Errorf("searching for order submitted txs on chain %s: %w", chain.ChainID, err)
+		}
+
+		for _, tx := range searchResult.Txs {
+			events, err := parseOrderSubmittedEvents(tx.TxResult, chain.FastTransferContractAddress)
+			if err != nil {
+				return nil, 0, fmt.Errorf("parsing order submitted events from tx %s on chain %s: %w", tx.Hash.String(), chain.ChainID, err)
+			}
+
+			for _, event := range events {
+				destinationChainID, err := destinationChainIDForDomain(ctx, event.order.DestinationDomain)
+				if err != nil {
+					return nil, 0, err
+				}
+				orders = append(orders, Order{
+					TxHash:             tx.Hash.String(),
+					TxBlockHeight:      uint64(tx.Height),
+					ChainID:            chain.ChainID,
+					DestinationChainID: destinationChainID,
+					ChainEnvironment:   chain.Environment,
+					OrderEvent:         event.order,
+					OrderID:            event.orderID,
+					TimeoutTimestamp:   int64(event.order.TimeoutTimestamp),
+				})
+			}
+		}
+
+		if page*perPage >= searchResult.TotalCount {
+			break
+		}
+	}
+
+	if len(orders) > 0 {
+		lmt.Logger(ctx).Info("Fast transfer orders found",
+			zap.String("chainID", chain.ChainID),
+			zap.Int("numOfOrders", len(orders)))
+	}
+
+	return orders, endBlockHeight, nil
+}
+
+type orderSubmittedEvent struct {
+	orderID string
+	order   fast_transfer_gateway.FastTransferOrder
+}
+
+// parseOrderSubmittedEvents finds all order submitted events emitted by the
+// gateway contract in a tx's results. The gateway emits the order id and the
+// hex encoded order bytes (in the same encoding as the evm gateway) as
+// attributes on the wasm event.
+func parseOrderSubmittedEvents(txResult abcitypes.ExecTxResult, gatewayContractAddress string) ([]orderSubmittedEvent, error) {
+	var events []orderSubmittedEvent
+	for _, event := range txResult.GetEvents() {
+		if event.GetType() != "wasm" {
+			continue
+		}
+
+		var contractAddress, action, orderID, encodedOrder string
+		for _, attribute := range event.GetAttributes() {
+			switch attribute.GetKey() {
+			case "_contract_address":
+				contractAddress = attribute.GetValue()
+			case "action":
+				action = attribute.GetValue()
+			case "order_id":
+				orderID = attribute.GetValue()
+			case "order":
+				encodedOrder = attribute.GetValue()
+			}
+		}
+		if contractAddress != gatewayContractAddress || action != orderSubmittedAction {
+			continue
+		}
+
+		if orderID == "" {
+			return nil, fmt.Errorf("order submitted event is missing order id")
+		}
+		orderBytes, err := hex.DecodeString(encodedOrder)
+		if err != nil {
+			return nil, fmt.Errorf("decoding order %s: %w", orderID, err)
+		}
+		// sender, recipient, amount in, amount out, nonce, source domain,
+		// destination domain and timeout timestamp are fixed size
+		if len(orderBytes) < 148 {
+			return nil, fmt.Errorf("expected order %s to be at least 148 bytes but got %d", orderID, len(orderBytes))
+		}
+
+		events = append(events, orderSubmittedEvent{
+			orderID: orderID,
+			order:   fast_transfer_gateway.DecodeOrder(orderBytes),
+		})
+	}
+	return events, nil
+}
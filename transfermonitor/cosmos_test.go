@@ -0,0 +1,180 @@
+package transfermonitor
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cometclient "github.com/skip-mev/go-fast-solver/mocks/github.com/cometbft/cometbft/rpc/client"
+	"github.com/skip-mev/go-fast-solver/mocks/shared/tmrpc"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/contracts/fast_transfer_gateway"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeTestOrder encodes an order in the format emitted by the gateway
+// contract, with a fixed sender, recipient and nonce
+func encodeTestOrder(amountIn, amountOut int64, destinationDomain uint32, timeoutTimestamp uint64, data []byte) []byte {
+	order := make([]byte, 148)
+	order[31] = 1
+	order[63] = 2
+	big.NewInt(amountIn).FillBytes(order[64:96])
+	big.NewInt(amountOut).FillBytes(order[96:128])
+	binary.BigEndian.PutUint32(order[128:132], 7)
+	binary.BigEndian.PutUint32(order[132:136], 875)
+	binary.BigEndian.PutUint32(order[136:140], destinationDomain)
+	binary.BigEndian.PutUint64(order[140:148], timeoutTimestamp)
+	return append(order, data...)
+}
+
+func orderSubmittedWasmEvent(contractAddress, action, orderID, encodedOrder string) abcitypes.Event {
+	return abcitypes.Event{
+		Type: "wasm",
+		Attributes: []abcitypes.EventAttribute{
+			{Key: "_contract_address", Value: contractAddress},
+			{Key: "action", Value: action},
+			{Key: "order_id", Value: orderID},
+			{Key: "order", Value: encodedOrder},
+		},
+	}
+}
+
+func TestParseOrderSubmittedEvents(t *testing.T) {
+	order := encodeTestOrder(1000, 990, 42161, 1700000000, []byte("data"))
+	encodedOrder := hex.EncodeToString(order)
+	decodedOrder := fast_transfer_gateway.DecodeOrder(order)
+
+	tests := []struct {
+		name           string
+		events         []abcitypes.Event
+		expectedEvents []orderSubmittedEvent
+		expectedErr    string
+	}{
+		{
+			name:           "order submitted event",
+			events:         []abcitypes.Event{orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "aa", encodedOrder)},
+			expectedEvents: []orderSubmittedEvent{{orderID: "aa", order: decodedOrder}},
+		},
+		{
+			name: "multiple order submitted events in one tx",
+			events: []abcitypes.Event{
+				orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "aa", encodedOrder),
+				{Type: "transfer", Attributes: []abcitypes.EventAttribute{{Key: "amount", Value: "1000uusdc"}}},
+				orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "bb", encodedOrder),
+			},
+			expectedEvents: []orderSubmittedEvent{
+				{orderID: "aa", order: decodedOrder},
+				{orderID: "bb", order: decodedOrder},
+			},
+		},
+		{
+			name:   "event from another contract is skipped",
+			events: []abcitypes.Event{orderSubmittedWasmEvent("osmo1other", orderSubmittedAction, "aa", encodedOrder)},
+		},
+		{
+			name:   "event with another action is skipped",
+			events: []abcitypes.Event{orderSubmittedWasmEvent(testGateway, "order_filled", "aa", encodedOrder)},
+		},
+		{
+			name:   "non wasm event is skipped",
+			events: []abcitypes.Event{{Type: "message", Attributes: []abcitypes.EventAttribute{{Key: "action", Value: orderSubmittedAction}}}},
+		},
+		{
+			name:        "missing order id",
+			events:      []abcitypes.Event{orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "", encodedOrder)},
+			expectedErr: "order submitted event is missing order id",
+		},
+		{
+			name:        "order is not hex",
+			events:      []abcitypes.Event{orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "aa", "zz")},
+			expectedErr: "decoding order aa",
+		},
+		{
+			name:        "order is too short",
+			events:      []abcitypes.Event{orderSubmittedWasmEvent(testGateway, orderSubmittedAction, "aa", encodedOrder[:2*147])},
+			expectedErr: "expected order aa to be at least 148 bytes but got 147",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := parseOrderSubmittedEvents(abcitypes.ExecTxResult{Events: tt.events}, testGateway)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEvents, events)
+		})
+	}
+}
+
+func TestFindNewTransferIntentsOnCosmosChain(t *testing.T) {
+	ctx := config.ConfigReaderContext(context.Background(), config.NewConfigReader(config.Config{
+		Chains: map[string]config.ChainConfig{
+			testChainID: testChain,
+			"42161":     {ChainID: "42161", Type: config.ChainType_EVM, HyperlaneDomain: "42161"},
+		},
+	}))
+
+	// enough order submitted txs to span two pages of tx search results, the
+	// last of which is to an unsupported destination domain
+	numTxs := cosmosTxSearchPageSize + cosmosTxSearchPageSize/2
+	txs := make([]*coretypes.ResultTx, numTxs)
+	for i := range txs {
+		destinationDomain := uint32(42161)
+		if i == numTxs-1 {
+			destinationDomain = 1
+		}
+		encodedOrder := hex.EncodeToString(encodeTestOrder(1000, 990, destinationDomain, 1700000000, nil))
+		txs[i] = &coretypes.ResultTx{
+			Hash:   []byte{byte(i)},
+			Height: int64(10 + i/10),
+			TxResult: abcitypes.ExecTxResult{Events: []abcitypes.Event{
+				orderSubmittedWasmEvent(testGateway, orderSubmittedAction, fmt.Sprintf("%04d", i), encodedOrder),
+			}},
+		}
+	}
+
+	expectedQuery := fmt.Sprintf("wasm._contract_address='%s' AND wasm.action='%s' AND tx.height>=10 AND tx.height<=50", testGateway, orderSubmittedAction)
+	var pagesSearched []int
+	client := cometclient.NewMockClient(t)
+	client.EXPECT().Status(mock.Anything).Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 50}}, nil)
+	client.EXPECT().TxSearch(mock.Anything, expectedQuery, false, mock.Anything, mock.Anything, "asc").RunAndReturn(
+		func(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+			pagesSearched = append(pagesSearched, *page)
+			start := (*page - 1) * *perPage
+			end := start + *perPage
+			if end > len(txs) {
+				end = len(txs)
+			}
+			return &coretypes.ResultTxSearch{Txs: txs[start:end], TotalCount: len(txs)}, nil
+		},
+	)
+	tmRPCManager := tmrpc.NewMockTendermintRPCClientManager(t)
+	tmRPCManager.EXPECT().GetClient(mock.Anything, testChainID).Return(client, nil)
+
+	monitor := &TransferMonitor{tmRPCManager: tmRPCManager}
+	orders, endBlockHeight, err := monitor.findNewTransferIntentsOnCosmosChain(ctx, testChain, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(50), endBlockHeight)
+	assert.Equal(t, []int{1, 2}, pagesSearched)
+	require.Len(t, orders, numTxs)
+	for i, order := range orders {
+		assert.Equal(t, fmt.Sprintf("%04d", i), order.OrderID)
+		assert.Equal(t, txs[i].Hash.String(), order.TxHash)
+		assert.Equal(t, uint64(txs[i].Height), order.TxBlockHeight)
+		assert.Equal(t, testChainID, order.ChainID)
+		assert.Equal(t, int64(1700000000), order.TimeoutTimestamp)
+	}
+	assert.Equal(t, "42161", orders[0].DestinationChainID)
+	assert.Equal(t, "", orders[numTxs-1].DestinationChainID)
+}
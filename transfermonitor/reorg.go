@@ -0,0 +1,203 @@
+package transfermonitor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/skip-mev/go-fast-solver/shared/lmt"
+	"github.com/skip-mev/go-fast-solver/shared/metrics"
+	"go.uber.org/zap"
+)
+
+// markReorgedOrders compares the block hashes recorded for heights between
+// startBlockHeight and endBlockHeight with the chains current canonical block
+// hashes. Orders created at a height whose block has changed that were not
+// found again by the rescan of that range have been orphaned by a reorg, and
+// are marked as reorged. Orders that the rescan found again in a different
+// block or transaction have their creation tx and height updated.
+func (t *TransferMonitor) markReorgedOrders(ctx context.Context, chain config.ChainConfig, startBlockHeight, endBlockHeight uint64, rescannedOrders []Order) error {
+	blockHashes, err := t.db.GetTransferMonitorBlockHashesInRange(ctx, db.GetTransferMonitorBlockHashesInRangeParams{
+		ChainID:  chain.ChainID,
+		Height:   int64(startBlockHeight),
+		Height_2: int64(endBlockHeight),
+	})
+	if err != nil {
+		return fmt.Errorf("getting block hashes between heights %d and %d: %w", startBlockHeight, endBlockHeight, err)
+	}
+
+	reorgedHeights := make(map[int64]bool)
+	for _, blockHash := range blockHashes {
+		canonicalHash, err := t.getBlockHash(ctx, chain, uint64(blockHash.Height))
+		if err != nil {
+			return fmt.Errorf("getting block hash at height %d: %w", blockHash.Height, err)
+		}
+		if canonicalHash == blockHash.BlockHash {
+			continue
+		}
+
+		lmt.Logger(ctx).Warn(
+			"detected reorg",
+			zap.String("chain_id", chain.ChainID),
+			zap.Int64("height", blockHash.Height),
+			zap.String("recorded_block_hash", blockHash.BlockHash),
+			zap.String("canonical_block_hash", canonicalHash),
+		)
+		reorgedHeights[blockHash.Height] = true
+
+		if _, err := t.db.InsertTransferMonitorBlockHash(ctx, db.InsertTransferMonitorBlockHashParams{
+			ChainID:   chain.ChainID,
+			Height:    blockHash.Height,
+			BlockHash: canonicalHash,
+		}); err != nil {
+			return fmt.Errorf("updating block hash at height %d: %w", blockHash.Height, err)
+		}
+	}
+	if len(reorgedHeights) == 0 {
+		return nil
+	}
+
+	canonicalOrders := make(map[string]Order)
+	for _, order := range rescannedOrders {
+		canonicalOrders[order.OrderID] = order
+	}
+
+	orders, err := t.db.GetOrdersInSourceChainBlockRange(ctx, db.GetOrdersInSourceChainBlockRangeParams{
+		SourceChainID:                chain.ChainID,
+		OrderCreationTxBlockHeight:   int64(startBlockHeight),
+		OrderCreationTxBlockHeight_2: int64(endBlockHeight),
+	})
+	if err != nil {
+		return fmt.Errorf("getting orders between heights %d and %d: %w", startBlockHeight, endBlockHeight, err)
+	}
+	for _, order := range orders {
+		if !reorgedHeights[order.OrderCreationTxBlockHeight] {
+			continue
+		}
+
+		if canonicalOrder, ok := canonicalOrders[order.OrderID]; ok {
+			if int64(canonicalOrder.TxBlockHeight) == order.OrderCreationTxBlockHeight && canonicalOrder.TxHash == order.OrderCreationTx {
+				continue
+			}
+			if _, err := t.db.SetOrderCreationTx(ctx, db.SetOrderCreationTxParams{
+				SourceChainID:                     order.SourceChainID,
+				OrderID:                           order.OrderID,
+				SourceChainGatewayContractAddress: order.SourceChainGatewayContractAddress,
+				OrderCreationTx:                   canonicalOrder.TxHash,
+				OrderCreationTxBlockHeight:        int64(canonicalOrder.TxBlockHeight),
+			}); err != nil {
+				return fmt.Errorf("updating order %s creation tx: %w", order.OrderID, err)
+			}
+			lmt.Logger(ctx).Info(
+				"order was re-included in the canonical chain after a reorg",
+				zap.String("order_id", order.OrderID),
+				zap.String("source_chain_id", order.SourceChainID),
+				zap.Int64("previous_height", order.OrderCreationTxBlockHeight),
+				zap.Uint64("height", canonicalOrder.TxBlockHeight),
+				zap.String("tx_hash", canonicalOrder.TxHash),
+			)
+			continue
+		}
+
+		if order.OrderStatus != dbtypes.OrderStatusPending {
+			lmt.Logger(ctx).Error(
+				"order was reorged out of the canonical chain after leaving pending status",
+				zap.String("order_id", order.OrderID),
+				zap.String("source_chain_id", order.SourceChainID),
+				zap.String("order_status", order.OrderStatus),
+				zap.Int64("height", order.OrderCreationTxBlockHeight),
+			)
+			continue
+		}
+
+		if _, err := t.db.SetOrderStatus(ctx, db.SetOrderStatusParams{
+			SourceChainID:                     order.SourceChainID,
+			OrderID:                           order.OrderID,
+			SourceChainGatewayContractAddress: order.SourceChainGatewayContractAddress,
+			OrderStatus:                       dbtypes.OrderStatusReorged,
+			OrderStatusMessage: sql.NullString{
+				String: fmt.Sprintf("order creation block at height %d was reorged out of the canonical chain", order.OrderCreationTxBlockHeight),
+				Valid:  true,
+			},
+		}); err != nil {
+			return fmt.Errorf("setting order %s status to reorged: %w", order.OrderID, err)
+		}
+		metrics.FromContext(ctx).IncFillOrderStatusChange(order.SourceChainID, order.DestinationChainID, dbtypes.OrderStatusReorged)
+		lmt.Logger(ctx).Info(
+			"marked order as reorged",
+			zap.String("order_id", order.OrderID),
+			zap.String("source_chain_id", order.SourceChainID),
+			zap.Int64("height", order.OrderCreationTxBlockHeight),
+		)
+	}
+	return nil
+}
+
+// recordBlockHashes records the block hashes of the heights orders were found
+// at and of the last scanned height, so that reorgs of those blocks can be
+// detected when they are rescanned. Hashes recorded below pruneBelowHeight are
+// outside of the finality window and are deleted.
+func (t *TransferMonitor) recordBlockHashes(ctx context.Context, chain config.ChainConfig, orders []Order, endBlockHeight, pruneBelowHeight uint64) error {
+	blockHashes := map[uint64]string{endBlockHeight: ""}
+	for _, order := range orders {
+		blockHashes[order.TxBlockHeight] = order.BlockHash
+	}
+
+	for height, blockHash := range blockHashes {
+		if blockHash == "" {
+			var err error
+			blockHash, err = t.getBlockHash(ctx, chain, height)
+			if err != nil {
+				return fmt.Errorf("getting block hash at height %d: %w", height, err)
+			}
+		}
+		if _, err := t.db.InsertTransferMonitorBlockHash(ctx, db.InsertTransferMonitorBlockHashParams{
+			ChainID:   chain.ChainID,
+			Height:    int64(height),
+			BlockHash: blockHash,
+		}); err != nil {
+			return fmt.Errorf("inserting block hash at height %d: %w", height, err)
+		}
+	}
+
+	if err := t.db.DeleteTransferMonitorBlockHashesBelowHeight(ctx, db.DeleteTransferMonitorBlockHashesBelowHeightParams{
+		ChainID: chain.ChainID,
+		Height:  int64(pruneBelowHeight),
+	}); err != nil {
+		return fmt.Errorf("pruning block hashes below height %d: %w", pruneBelowHeight, err)
+	}
+	return nil
+}
+
+// getBlockHash returns the hash of the canonical block at height on a chain
+func (t *TransferMonitor) getBlockHash(ctx context.Context, chain config.ChainConfig, height uint64) (string, error) {
+	switch chain.Type {
+	case config.ChainType_EVM:
+		client, err := t.getClient(ctx, chain.ChainID)
+		if err != nil {
+			return "", err
+		}
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(height))
+		if err != nil {
+			return "", err
+		}
+		return header.Hash().Hex(), nil
+	case config.ChainType_COSMOS:
+		client, err := t.tmRPCManager.GetClient(ctx, chain.ChainID)
+		if err != nil {
+			return "", err
+		}
+		h := int64(height)
+		block, err := client.Block(ctx, &h)
+		if err != nil {
+			return "", err
+		}
+		return block.BlockID.Hash.String(), nil
+	default:
+		return "", fmt.Errorf("unknown chain type")
+	}
+}
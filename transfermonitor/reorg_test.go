@@ -0,0 +1,174 @@
+package transfermonitor
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/db/connect"
+	"github.com/skip-mev/go-fast-solver/db/gen/db"
+	cometclient "github.com/skip-mev/go-fast-solver/mocks/github.com/cometbft/cometbft/rpc/client"
+	"github.com/skip-mev/go-fast-solver/mocks/shared/tmrpc"
+	"github.com/skip-mev/go-fast-solver/shared/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID = "osmosis-1"
+	testGateway = "osmo1gateway"
+)
+
+var testChain = config.ChainConfig{
+	ChainID:                     testChainID,
+	Type:                        config.ChainType_COSMOS,
+	FastTransferContractAddress: testGateway,
+	FinalityWindowBlocks:        10,
+}
+
+func newTestDB(t *testing.T) *db.Queries {
+	conn, err := connect.ConnectAndMigrate(context.Background(), filepath.Join(t.TempDir(), "solver.db"), "../db/migrations")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return db.New(conn)
+}
+
+// newTestTransferMonitor returns a transfer monitor whose cosmos rpc client
+// returns a block with the hash in canonicalHashes for each height
+func newTestTransferMonitor(t *testing.T, database *db.Queries, canonicalHashes map[int64][]byte) *TransferMonitor {
+	client := cometclient.NewMockClient(t)
+	client.EXPECT().Block(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+		hash, ok := canonicalHashes[*height]
+		if !ok {
+			return nil, fmt.Errorf("no block at height %d", *height)
+		}
+		return &coretypes.ResultBlock{BlockID: cmttypes.BlockID{Hash: hash}}, nil
+	}).Maybe()
+
+	tmRPCManager := tmrpc.NewMockTendermintRPCClientManager(t)
+	tmRPCManager.EXPECT().GetClient(mock.Anything, testChainID).Return(client, nil).Maybe()
+
+	return &TransferMonitor{db: database, tmRPCManager: tmRPCManager}
+}
+
+func insertTestOrder(t *testing.T, ctx context.Context, database *db.Queries, orderID, txHash string, height int64, status string) {
+	_, err := database.InsertOrder(ctx, db.InsertOrderParams{
+		SourceChainID:                     testChainID,
+		DestinationChainID:                "42161",
+		SourceChainGatewayContractAddress: testGateway,
+		Sender:                            []byte("sender"),
+		Recipient:                         []byte("recipient"),
+		AmountIn:                          "1000",
+		AmountOut:                         "990",
+		OrderCreationTx:                   txHash,
+		OrderCreationTxBlockHeight:        height,
+		OrderID:                           orderID,
+		OrderStatus:                       status,
+		TimeoutTimestamp:                  time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+}
+
+func insertTestBlockHash(t *testing.T, ctx context.Context, database *db.Queries, height int64, hash []byte) {
+	_, err := database.InsertTransferMonitorBlockHash(ctx, db.InsertTransferMonitorBlockHashParams{
+		ChainID:   testChainID,
+		Height:    height,
+		BlockHash: cmtbytes.HexBytes(hash).String(),
+	})
+	require.NoError(t, err)
+}
+
+func blockHashesByHeight(t *testing.T, ctx context.Context, database *db.Queries) map[int64]string {
+	blockHashes, err := database.GetTransferMonitorBlockHashesInRange(ctx, db.GetTransferMonitorBlockHashesInRangeParams{
+		ChainID:  testChainID,
+		Height:   0,
+		Height_2: 100,
+	})
+	require.NoError(t, err)
+
+	byHeight := make(map[int64]string)
+	for _, blockHash := range blockHashes {
+		byHeight[blockHash.Height] = blockHash.BlockHash
+	}
+	return byHeight
+}
+
+func TestMarkReorgedOrders(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDB(t)
+
+	insertTestBlockHash(t, ctx, database, 5, []byte{0xa5})
+	insertTestBlockHash(t, ctx, database, 6, []byte{0xa6})
+	insertTestBlockHash(t, ctx, database, 7, []byte{0xa7})
+
+	// orphaned by the reorg of block 5
+	insertTestOrder(t, ctx, database, "reorged", "tx1", 5, dbtypes.OrderStatusPending)
+	// orphaned by the reorg of block 5 after it was already filled
+	insertTestOrder(t, ctx, database, "filled", "tx2", 5, dbtypes.OrderStatusFilled)
+	// re-included at height 8 after the reorg of block 6
+	insertTestOrder(t, ctx, database, "reincluded", "tx3", 6, dbtypes.OrderStatusPending)
+	// block 7 was not reorged
+	insertTestOrder(t, ctx, database, "unchanged", "tx4", 7, dbtypes.OrderStatusPending)
+
+	monitor := newTestTransferMonitor(t, database, map[int64][]byte{
+		5: {0xb5},
+		6: {0xb6},
+		7: {0xa7},
+	})
+	rescannedOrders := []Order{
+		{OrderID: "reincluded", TxHash: "tx3b", TxBlockHeight: 8},
+	}
+	require.NoError(t, monitor.markReorgedOrders(ctx, testChain, 0, 10, rescannedOrders))
+
+	tests := []struct {
+		orderID        string
+		expectedStatus string
+		expectedTx     string
+		expectedHeight int64
+	}{
+		{orderID: "reorged", expectedStatus: dbtypes.OrderStatusReorged, expectedTx: "tx1", expectedHeight: 5},
+		{orderID: "filled", expectedStatus: dbtypes.OrderStatusFilled, expectedTx: "tx2", expectedHeight: 5},
+		{orderID: "reincluded", expectedStatus: dbtypes.OrderStatusPending, expectedTx: "tx3b", expectedHeight: 8},
+		{orderID: "unchanged", expectedStatus: dbtypes.OrderStatusPending, expectedTx: "tx4", expectedHeight: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orderID, func(t *testing.T) {
+			order, err := database.GetOrderByOrderID(ctx, tt.orderID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, order.OrderStatus)
+			assert.Equal(t, tt.expectedTx, order.OrderCreationTx)
+			assert.Equal(t, tt.expectedHeight, order.OrderCreationTxBlockHeight)
+		})
+	}
+
+	assert.Equal(t, map[int64]string{5: "B5", 6: "B6", 7: "A7"}, blockHashesByHeight(t, ctx, database))
+}
+
+func TestRecordBlockHashes(t *testing.T) {
+	ctx := context.Background()
+	database := newTestDB(t)
+
+	insertTestBlockHash(t, ctx, database, 2, []byte{0xa2})
+	insertTestBlockHash(t, ctx, database, 6, []byte{0xa6})
+
+	monitor := newTestTransferMonitor(t, database, map[int64][]byte{
+		7:  {0xb7},
+		10: {0xc0},
+	})
+	orders := []Order{
+		// block hash is known from the scan
+		{OrderID: "a", TxBlockHeight: 4, BlockHash: "A4"},
+		// block hash must be fetched from the chain
+		{OrderID: "b", TxBlockHeight: 7},
+	}
+	require.NoError(t, monitor.recordBlockHashes(ctx, testChain, orders, 10, 3))
+
+	assert.Equal(t, map[int64]string{4: "A4", 6: "A6", 7: "B7", 10: "C0"}, blockHashesByHeight(t, ctx, database))
+}
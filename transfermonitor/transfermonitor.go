@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -26,18 +28,29 @@ import (
 )
 
 const (
-	maxBlocksProcessedPerIteration = 100000
-	destinationChainID             = "osmosis-1"
+	maxBlocksProcessedPerIteration = config.TransferMonitorMaxBlocksPerIteration
 )
 
 type MonitorDBQueries interface {
 	InsertTransferMonitorMetadata(ctx context.Context, arg db.InsertTransferMonitorMetadataParams) (db.TransferMonitorMetadatum, error)
 	GetTransferMonitorMetadata(ctx context.Context, chainID string) (db.TransferMonitorMetadatum, error)
 	InsertOrder(ctx context.Context, arg db.InsertOrderParams) (db.Order, error)
+	GetOrdersInSourceChainBlockRange(ctx context.Context, arg db.GetOrdersInSourceChainBlockRangeParams) ([]db.Order, error)
+	SetOrderStatus(ctx context.Context, arg db.SetOrderStatusParams) (db.Order, error)
+	SetOrderCreationTx(ctx context.Context, arg db.SetOrderCreationTxParams) (db.Order, error)
+	InsertTransferMonitorBlockHash(ctx context.Context, arg db.InsertTransferMonitorBlockHashParams) (db.TransferMonitorBlockHash, error)
+	GetTransferMonitorBlockHashesInRange(ctx context.Context, arg db.GetTransferMonitorBlockHashesInRangeParams) ([]db.TransferMonitorBlockHash, error)
+	DeleteTransferMonitorBlockHashesBelowHeight(ctx context.Context, arg db.DeleteTransferMonitorBlockHashesBelowHeightParams) error
+}
+
+// OrderPublisher is notified of each new order the transfer monitor inserts
+type OrderPublisher interface {
+	Publish(order db.Order) (dropped int)
 }
 
 type TransferMonitor struct {
 	db            MonitorDBQueries
+	newOrders     OrderPublisher
 	clients       map[string]*ethclient.Client
 	tmRPCManager  tmrpc.TendermintRPCClientManager
 	quickStart    bool
@@ -45,12 +58,13 @@ type TransferMonitor struct {
 	ticker        *time.Ticker
 }
 
-func NewTransferMonitor(db MonitorDBQueries, quickStart bool, pollInterval *time.Duration) *TransferMonitor {
+func NewTransferMonitor(db MonitorDBQueries, newOrders OrderPublisher, quickStart bool, pollInterval *time.Duration) *TransferMonitor {
 	if pollInterval == nil {
 		pollInterval = &[]time.Duration{5 * time.Second}[0]
 	}
 	return &TransferMonitor{
 		db:            db,
+		newOrders:     newOrders,
 		clients:       make(map[string]*ethclient.Client),
 		tmRPCManager:  tmrpc.NewTendermintRPCClientManager(),
 		quickStart:    quickStart,
@@ -71,6 +85,15 @@ func (t *TransferMonitor) Start(ctx context.Context) error {
 			chains = append(chains, chain)
 		}
 	}
+	cosmosChains, err := config.GetConfigReader(ctx).GetAllChainConfigsOfType(config.ChainType_COSMOS)
+	if err != nil {
+		return fmt.Errorf("error getting Cosmos chains: %w", err)
+	}
+	for _, chain := range cosmosChains {
+		if chain.FastTransferContractAddress != "" {
+			chains = append(chains, chain)
+		}
+	}
 
 	for {
 		select {
@@ -108,27 +131,61 @@ func (t *TransferMonitor) Start(ctx context.Context) error {
 					t.didQuickStart[chainID] = true
 				}
 
-				lmt.Logger(ctx).Debug("Processing new blocks", zap.String("chain_id", chainID), zap.Uint64("height", startBlockHeight))
+				// rescan the finality window below the last scanned height to
+				// detect orders that have been reorged out of the chain
+				scanStartHeight := startBlockHeight - min(chain.FinalityWindowBlocks, startBlockHeight)
+
+				lmt.Logger(ctx).Debug("Processing new blocks", zap.String("chain_id", chainID), zap.Uint64("height", startBlockHeight), zap.Uint64("rescan_height", scanStartHeight))
 				var orders []Order
 				var endBlockHeight uint64
 				var fastTransferGatewayContractAddress string
 				switch chain.Type {
 				case config.ChainType_EVM:
 					fastTransferGatewayContractAddress = chain.FastTransferContractAddress
-					orders, endBlockHeight, err = t.findNewTransferIntentsOnEVMChain(ctx, chain, startBlockHeight)
+					orders, endBlockHeight, err = t.findNewTransferIntentsOnEVMChain(ctx, chain, scanStartHeight)
 					if err != nil {
 						lmt.Logger(ctx).Error("Error finding burn transactions", zap.Error(err))
 						continue
 					}
+				case config.ChainType_COSMOS:
+					fastTransferGatewayContractAddress = chain.FastTransferContractAddress
+					orders, endBlockHeight, err = t.findNewTransferIntentsOnCosmosChain(ctx, chain, scanStartHeight)
+					if err != nil {
+						lmt.Logger(ctx).Error("Error finding order submitted transactions", zap.Error(err))
+						continue
+					}
 				default:
 					lmt.Logger(ctx).Error("Unsupported chain type", zap.String("chain_type", string(chain.Type)))
 					continue
 				}
 
+				if chain.FinalityWindowBlocks > 0 {
+					if err := t.markReorgedOrders(ctx, chain, scanStartHeight, min(startBlockHeight, endBlockHeight), orders); err != nil {
+						lmt.Logger(ctx).Error("Error checking for reorged orders", zap.String("chain_id", chainID), zap.Error(err))
+						continue
+					}
+				}
+
 				errorInsertingOrder := false
 				if len(orders) > 0 {
 					lmt.Logger(ctx).Info("Found burn transactions", zap.Int("count", len(orders)), zap.String("chain_id", chainID))
 					for _, order := range orders {
+						orderStatus := dbtypes.OrderStatusPending
+						var orderStatusMessage sql.NullString
+						if order.DestinationChainID == "" {
+							lmt.Logger(ctx).Warn(
+								"order submitted to unsupported destination domain",
+								zap.String("order_id", order.OrderID),
+								zap.String("source_chain_id", order.ChainID),
+								zap.Uint32("destination_domain", order.OrderEvent.DestinationDomain),
+							)
+							orderStatus = dbtypes.OrderStatusUnsupportedRoute
+							orderStatusMessage = sql.NullString{
+								String: fmt.Sprintf("no chain configured for destination hyperlane domain %d", order.OrderEvent.DestinationDomain),
+								Valid:  true,
+							}
+						}
+
 						toInsert := db.InsertOrderParams{
 							SourceChainID:                     order.ChainID,
 							DestinationChainID:                order.DestinationChainID,
@@ -141,21 +198,36 @@ func (t *TransferMonitor) Start(ctx context.Context) error {
 							OrderCreationTx:                   order.TxHash,
 							OrderCreationTxBlockHeight:        int64(order.TxBlockHeight),
 							OrderID:                           order.OrderID,
-							OrderStatus:                       dbtypes.OrderStatusPending,
+							OrderStatus:                       orderStatus,
+							OrderStatusMessage:                orderStatusMessage,
 							TimeoutTimestamp:                  time.Unix(order.TimeoutTimestamp, 0).UTC(),
 						}
 						if len(order.OrderEvent.Data) > 0 {
 							toInsert.Data = sql.NullString{String: hex.EncodeToString(order.OrderEvent.Data), Valid: true}
 						}
 
-						_, err := t.db.InsertOrder(ctx, toInsert)
+						insertedOrder, err := t.db.InsertOrder(ctx, toInsert)
 						if err != nil && !strings.Contains(err.Error(), "sql: no rows in result set") {
 
 							lmt.Logger(ctx).Error("Error inserting order", zap.Error(err))
 							errorInsertingOrder = true
 							break
 						}
-						metrics.FromContext(ctx).IncFillOrderStatusChange(order.ChainID, order.DestinationChainID, dbtypes.OrderStatusPending)
+						// orders that already existed (i.e. found again by a
+						// rescan) are not returned by the insert
+						if err != nil {
+							continue
+						}
+						metrics.FromContext(ctx).IncFillOrderStatusChange(order.ChainID, order.DestinationChainID, orderStatus)
+						if insertedOrder.OrderStatus == dbtypes.OrderStatusPending {
+							if dropped := t.newOrders.Publish(insertedOrder); dropped > 0 {
+								lmt.Logger(ctx).Debug(
+									"new order event dropped by subscribers",
+									zap.String("order_id", insertedOrder.OrderID),
+									zap.Int("dropped", dropped),
+								)
+							}
+						}
 					}
 				}
 				lmt.Logger(ctx).Debug("num orders found while processing blocks", zap.Int("numOrders", len(orders)))
@@ -163,6 +235,13 @@ func (t *TransferMonitor) Start(ctx context.Context) error {
 					continue
 				}
 
+				if chain.FinalityWindowBlocks > 0 {
+					if err := t.recordBlockHashes(ctx, chain, orders, endBlockHeight, scanStartHeight); err != nil {
+						lmt.Logger(ctx).Error("Error recording block hashes", zap.String("chain_id", chainID), zap.Error(err))
+						continue
+					}
+				}
+
 				_, err = t.db.InsertTransferMonitorMetadata(ctx, db.InsertTransferMonitorMetadataParams{
 					ChainID:        chainID,
 					HeightLastSeen: int64(endBlockHeight),
@@ -252,8 +331,11 @@ func (t *TransferMonitor) getClient(ctx context.Context, chainID string) (*ethcl
 }
 
 type Order struct {
-	TxHash             string                                  `json:"tx_hash"`
-	TxBlockHeight      uint64                                  `json:"tx_block_height"`
+	TxHash        string `json:"tx_hash"`
+	TxBlockHeight uint64 `json:"tx_block_height"`
+	// BlockHash is the hash of the block the order was created in, empty if
+	// it was not returned when searching for the order
+	BlockHash          string                                  `json:"block_hash"`
 	ChainID            string                                  `json:"chain_id"`
 	DestinationChainID string                                  `json:"destination_chain_id"`
 	ChainEnvironment   config.ChainEnvironment                 `json:"chain_environment"`
@@ -311,11 +393,16 @@ OuterLoop:
 				}
 
 				for iter.Next() {
-					m.Lock()
 					orderData := fast_transfer_gateway.DecodeOrder(iter.Event.Order)
+					destinationChainID, err := destinationChainIDForDomain(ctx, orderData.DestinationDomain)
+					if err != nil {
+						return err
+					}
+					m.Lock()
 					orders = append(orders, Order{
 						TxHash:             iter.Event.Raw.TxHash.Hex(),
 						TxBlockHeight:      iter.Event.Raw.BlockNumber,
+						BlockHash:          iter.Event.Raw.BlockHash.Hex(),
 						ChainID:            chainID,
 						DestinationChainID: destinationChainID,
 						OrderEvent:         orderData,
@@ -343,6 +430,19 @@ OuterLoop:
 	return orders, nil
 }
 
+// destinationChainIDForDomain resolves the chain id of an orders destination
+// hyperlane domain. An empty string is returned if the domain is not
+// configured, i.e. the order is for a route the solver does not support.
+func destinationChainIDForDomain(ctx context.Context, domain uint32) (string, error) {
+	chainID, err := config.GetConfigReader(ctx).GetChainIDByHyperlaneDomain(strconv.FormatUint(uint64(domain), 10))
+	if errors.As(err, &config.ErrHyperlaneDomainNotFound{}) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("getting chain id for hyperlane domain %d: %w", domain, err)
+	}
+	return chainID, nil
+}
+
 func getChainID(chain config.ChainConfig) (string, error) {
 	switch chain.Type {
 	case config.ChainType_COSMOS:
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	dbtypes "github.com/skip-mev/go-fast-solver/db"
+	"github.com/skip-mev/go-fast-solver/inventory"
 	"github.com/skip-mev/go-fast-solver/shared/bridges/cctp"
 	"github.com/skip-mev/go-fast-solver/shared/clientmanager"
 	"github.com/skip-mev/go-fast-solver/shared/metrics"
@@ -45,13 +46,15 @@ type TxVerifier struct {
 	db            Database
 	clientManager *clientmanager.ClientManager
 	oracle        Oracle
+	inventory     *inventory.Ledger
 }
 
-func NewTxVerifier(ctx context.Context, db Database, clientManager *clientmanager.ClientManager, oracle Oracle) (*TxVerifier, error) {
+func NewTxVerifier(ctx context.Context, db Database, clientManager *clientmanager.ClientManager, oracle Oracle, inventory *inventory.Ledger) (*TxVerifier, error) {
 	return &TxVerifier{
 		db:            db,
 		clientManager: clientManager,
 		oracle:        oracle,
+		inventory:     inventory,
 	}, nil
 }
 
@@ -130,6 +133,7 @@ func (r *TxVerifier) VerifyTx(ctx context.Context, submittedTx db.SubmittedTx) e
 		}); err != nil {
 			return fmt.Errorf("failed to set tx status to failed: %w", err)
 		}
+		r.releaseFillReservation(submittedTx)
 		return fmt.Errorf("tx failed: %s", failure.String())
 	} else {
 		metrics.FromContext(ctx).IncTransactionVerified(true, submittedTx.ChainID)
@@ -147,6 +151,7 @@ func (r *TxVerifier) VerifyTx(ctx context.Context, submittedTx db.SubmittedTx) e
 		}); err != nil {
 			return fmt.Errorf("failed to set tx status to success: %w", err)
 		}
+		r.releaseFillReservation(submittedTx)
 	}
 	return nil
 }
@@ -160,7 +165,18 @@ func (r *TxVerifier) handleTxResultNotFound(ctx context.Context, submittedTx db.
 		}); err != nil {
 			return fmt.Errorf("failed to set tx status to abandoned: %w", err)
 		}
+		r.releaseFillReservation(submittedTx)
 	}
 
 	return nil
 }
+
+// releaseFillReservation releases the inventory reserved for a fill tx once it
+// is no longer pending. Once a fill has landed its amount is reflected in the
+// solvers on chain balance, so keeping the reservation would count it twice.
+func (r *TxVerifier) releaseFillReservation(submittedTx db.SubmittedTx) {
+	if submittedTx.TxType != dbtypes.TxTypeOrderFill || !submittedTx.OrderID.Valid {
+		return
+	}
+	r.inventory.ReleaseTx(submittedTx.OrderID.Int64, submittedTx.TxHash)
+}